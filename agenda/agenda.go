@@ -0,0 +1,105 @@
+package agenda
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/describe"
+)
+
+// Agenda lists the meetings of a Series.
+type Agenda struct {
+	Series  meetingtime.Series
+	Options describe.Options // Formatting of dates, times and descriptions of schedules
+}
+
+// Entry is a meeting in an Agenda.
+type Entry struct {
+	Time        time.Time // Time of the meeting in the Schedules, or of an added meeting
+	Description string    // Description of the Schedules of the meeting. Empty for added meetings.
+	Cancelled   bool      // The meeting will not take place
+	MovedTo     time.Time // Time the meeting takes place instead, if it has been moved
+	Added       bool      // The meeting is in addition to those from the Schedules
+}
+
+// Moved returns true if the meeting takes place at a different time than in the Schedules.
+func (e Entry) Moved() bool {
+	return !e.MovedTo.IsZero()
+}
+
+/*
+Entries returns the next n meetings of the Series after the given time, including cancelled and moved meetings at
+their times in the Schedules, and added meetings. Meetings moved to after the given time from before it are also
+included, at their times in the Schedules.
+
+Schedules that repeat in the same way share a description, as given by describe.ScheduleSliceParts.
+*/
+func (a Agenda) Entries(after time.Time, n int) ([]Entry, error) {
+	if err := a.Series.Validate(); err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+
+	floating := len(a.Series.Schedules) > 0 && a.Series.Schedules[0].Floating
+	at := func(t time.Time) time.Time {
+		if !floating {
+			return t
+		}
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), after.Location())
+	}
+
+	var entries []Entry
+	if len(a.Series.Schedules) > 0 {
+		parts, err := describe.ScheduleSliceParts(a.Series.Schedules)
+		if err != nil {
+			return nil, err
+		}
+		descriptions := make([]string, len(parts))
+		for i, p := range parts {
+			if descriptions[i], err = a.Options.ScheduleSlice(p.Schedules); err != nil {
+				return nil, err
+			}
+		}
+		description := func(t time.Time) string {
+			for i, p := range parts {
+				if p.Schedules.IsOccurrence(t) {
+					return descriptions[i]
+				}
+			}
+			return ""
+		}
+		for t := after; len(entries) < n; {
+			next, err := a.Series.Schedules.Next(t)
+			if err != nil {
+				return nil, err
+			}
+			e := Entry{Time: next, Description: description(next), Cancelled: a.Series.IsCancelled(next)}
+			e.MovedTo, _ = a.Series.MovedTo(next)
+			entries = append(entries, e)
+			t = next
+		}
+		for _, m := range a.Series.Moved {
+			if from, to := at(m.From), at(m.To); !from.After(after) && to.After(after) {
+				entries = append(entries, Entry{Time: from, Description: description(from), MovedTo: to})
+			}
+		}
+	}
+	for _, added := range a.Series.Added {
+		added = at(added)
+		if a.Series.Schedules.IsOccurrence(added) || !added.After(after) {
+			continue
+		}
+		entries = append(entries, Entry{Time: added, Added: true})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries, nil
+}
@@ -0,0 +1,165 @@
+package agenda
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/describe"
+)
+
+func testSeries(t *testing.T) meetingtime.Series {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.October, day, hour, 0, 0, 0, london)
+	}
+	return meetingtime.Series{
+		Schedules: meetingtime.ScheduleSlice{
+			meetingtime.NewWeeklySchedule(time.Date(2016, time.September, 5, 19, 0, 0, 0, london), 1),
+			meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 14, 12, 0, 0, 0, london)),
+		},
+		Cancelled: []time.Time{at(10, 19)},
+		Moved:     []meetingtime.Move{{From: at(17, 19), To: at(18, 19)}},
+		Added:     []time.Time{at(15, 10)},
+	}
+}
+
+func TestEntries(t *testing.T) {
+	series := testSeries(t)
+	london := series.Schedules[0].First.Location()
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.October, day, hour, 0, 0, 0, london)
+	}
+	after := time.Date(2016, time.October, 1, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name        string
+		series      meetingtime.Series
+		n           int
+		expected    []Entry
+		expectedErr error
+	}{
+		{
+			name:   "Changed meetings",
+			series: series,
+			n:      5,
+			expected: []Entry{
+				{Time: at(3, 19), Description: "Every Monday at 7:00PM"},
+				{Time: at(10, 19), Description: "Every Monday at 7:00PM", Cancelled: true},
+				{Time: at(12, 12), Description: "Every 2nd Wednesday at 12:00PM"},
+				{Time: at(15, 10), Added: true},
+				{Time: at(17, 19), Description: "Every Monday at 7:00PM", MovedTo: at(18, 19)},
+			},
+		},
+		{
+			name: "Moved from before",
+			series: meetingtime.Series{
+				Schedules: series.Schedules[:1],
+				Moved: []meetingtime.Move{
+					{From: time.Date(2016, time.September, 26, 19, 0, 0, 0, london), To: at(4, 19)},
+					{From: time.Date(2016, time.September, 19, 19, 0, 0, 0, london), To: time.Date(2016, time.September, 20, 19, 0, 0, 0, london)},
+				},
+			},
+			n: 3,
+			expected: []Entry{
+				{Time: time.Date(2016, time.September, 26, 19, 0, 0, 0, london), Description: "Every Monday at 7:00PM", MovedTo: at(4, 19)},
+				{Time: at(3, 19), Description: "Every Monday at 7:00PM"},
+				{Time: at(10, 19), Description: "Every Monday at 7:00PM"},
+			},
+		},
+		{
+			name:   "Added meetings only",
+			series: meetingtime.Series{Added: []time.Time{at(15, 10), at(1, 10), at(20, 10)}},
+			n:      5,
+			expected: []Entry{
+				{Time: at(1, 10), Added: true},
+				{Time: at(15, 10), Added: true},
+				{Time: at(20, 10), Added: true},
+			},
+		},
+		{
+			name:   "None",
+			series: series,
+		},
+		{
+			name:        "Invalid",
+			series:      meetingtime.Series{},
+			n:           5,
+			expectedErr: meetingtime.ErrEmptySchedule,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries, err := Agenda{Series: test.series, Options: describe.Options{OmitStart: true}}.Entries(after, test.n)
+			if err != test.expectedErr {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(entries, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, entries)
+			}
+		})
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	a := Agenda{Series: testSeries(t), Options: describe.Options{OmitStart: true, Clock24: true}}
+	var b bytes.Buffer
+	if err := a.WriteMarkdown(&b, time.Date(2016, time.October, 1, 0, 0, 0, 0, time.UTC), 6); err != nil {
+		t.Fatal(err)
+	}
+	expected := `| Date        | Time  | Schedule                     | Status                        |
+| ----------- | ----- | ---------------------------- | ----------------------------- |
+| Oct 03 2016 | 19:00 | Every Monday at 19:00        |                               |
+| Oct 10 2016 | 19:00 | Every Monday at 19:00        | Cancelled                     |
+| Oct 12 2016 | 12:00 | Every 2nd Wednesday at 12:00 |                               |
+| Oct 15 2016 | 10:00 |                              | Added                         |
+| Oct 17 2016 | 19:00 | Every Monday at 19:00        | Moved to Oct 18 2016 at 19:00 |
+| Oct 24 2016 | 19:00 | Every Monday at 19:00        |                               |
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestWriteMarkdownLocale(t *testing.T) {
+	a := Agenda{Series: testSeries(t), Options: describe.Options{Locale: describe.French, OmitStart: true}}
+	var b bytes.Buffer
+	if err := a.WriteMarkdown(&b, time.Date(2016, time.October, 16, 0, 0, 0, 0, time.UTC), 1); err != nil {
+		t.Fatal(err)
+	}
+	expected := `| Date            | Heure | Calendrier           | Statut                              |
+| --------------- | ----- | -------------------- | ----------------------------------- |
+| 17 octobre 2016 | 19:00 | Chaque lundi à 19:00 | Déplacée au 18 octobre 2016 à 19:00 |
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	a := Agenda{Series: testSeries(t), Options: describe.Options{OmitStart: true}}
+	var b bytes.Buffer
+	if err := a.WriteHTML(&b, time.Date(2016, time.October, 8, 0, 0, 0, 0, time.UTC), 3); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<ul class="agenda">
+<li class="cancelled"><time datetime="2016-10-10T19:00:00&#43;01:00">Oct 10 2016 7:00PM</time> <span class="schedule">Every Monday at 7:00PM</span> <span class="status">Cancelled</span></li>
+<li><time datetime="2016-10-12T12:00:00&#43;01:00">Oct 12 2016 12:00PM</time> <span class="schedule">Every 2nd Wednesday at 12:00PM</span></li>
+<li class="added"><time datetime="2016-10-15T10:00:00&#43;01:00">Oct 15 2016 10:00AM</time> <span class="status">Added</span></li>
+</ul>
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	if out, expected := escapeMarkdown("a|b_c\nd"), `a\|b\_c d`; out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+}
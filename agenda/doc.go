@@ -0,0 +1,12 @@
+/*
+Package agenda renders the upcoming meetings of a meeting series from the meetingtime package, as a Markdown table or
+an HTML list, for team wikis and email digests.
+
+Each meeting is shown with its date, time, a description of its schedule from the describe package, and whether it
+has been cancelled, moved or added. Output depends only on the Agenda and the times given, so it is suitable for
+caching and comparison.
+
+	a := agenda.Agenda{Series: meetingtime.Series{Schedules: schedules}}
+	err := a.WriteMarkdown(os.Stdout, time.Now(), 5)
+*/
+package agenda
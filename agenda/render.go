@@ -0,0 +1,138 @@
+package agenda
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/describe"
+)
+
+// row is an Entry formatted for rendering
+type row struct {
+	Time        time.Time
+	Date        string
+	Clock       string
+	Description string
+	Class       string
+	Status      string
+}
+
+/*
+WriteMarkdown writes the next n meetings after the given time as a Markdown table, with columns for the date, time,
+schedule and status of each meeting, headed in the language of the Locale of the Agenda's Options:
+
+	| Date        | Time   | Schedule               | Status    |
+	| ----------- | ------ | ---------------------- | --------- |
+	| Oct 03 2016 | 7:00PM | Every Monday at 7:00PM |           |
+	| Oct 10 2016 | 7:00PM | Every Monday at 7:00PM | Cancelled |
+*/
+func (a Agenda) WriteMarkdown(w io.Writer, after time.Time, n int) error {
+	rows, err := a.rows(after, n)
+	if err != nil {
+		return err
+	}
+	o := a.Options
+	table := [][]string{{
+		o.Message(describe.MessageAgendaDate, 0),
+		o.Message(describe.MessageAgendaTime, 0),
+		o.Message(describe.MessageAgendaSchedule, 0),
+		o.Message(describe.MessageAgendaStatus, 0),
+	}}
+	for _, r := range rows {
+		table = append(table, []string{r.Date, r.Clock, r.Description, r.Status})
+	}
+
+	widths := make([]int, len(table[0]))
+	for i, cells := range table {
+		for j, cell := range cells {
+			cell = escapeMarkdown(cell)
+			table[i][j] = cell
+			if width := len([]rune(cell)); width > widths[j] {
+				widths[j] = width
+			}
+		}
+	}
+	var b strings.Builder
+	for i, cells := range table {
+		writeMarkdownRow(&b, cells, widths)
+		if i == 0 {
+			rule := make([]string, len(widths))
+			for j, width := range widths {
+				rule[j] = strings.Repeat("-", width)
+			}
+			writeMarkdownRow(&b, rule, widths)
+		}
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string, widths []int) {
+	b.WriteString("|")
+	for j, cell := range cells {
+		fmt.Fprintf(b, " %s%s |", cell, strings.Repeat(" ", widths[j]-len([]rune(cell))))
+	}
+	b.WriteString("\n")
+}
+
+// escapeMarkdown escapes characters that would end a cell of a table, or be taken as formatting
+var escapeMarkdown = strings.NewReplacer(
+	`\`, `\\`, `|`, `\|`, `*`, `\*`, `_`, `\_`, "`", "\\`", "\n", " ",
+).Replace
+
+var htmlList = template.Must(template.New("agenda").Parse(`<ul class="agenda">
+{{- range .}}
+<li{{with .Class}} class="{{.}}"{{end}}><time datetime="{{.Time.Format "2006-01-02T15:04:05Z07:00"}}">{{.Date}} {{.Clock}}</time>
+{{- with .Description}} <span class="schedule">{{.}}</span>{{end}}
+{{- with .Status}} <span class="status">{{.}}</span>{{end}}</li>
+{{- end}}
+</ul>
+`))
+
+/*
+WriteHTML writes the next n meetings after the given time as an HTML list. Each item gives the date and time of the
+meeting in a time element, and its schedule and status in spans, with a class of "cancelled", "moved" or "added"
+for meetings that have changed. Statuses are given in the language of the Locale of the Agenda's Options:
+
+	<ul class="agenda">
+	<li class="cancelled"><time datetime="2016-10-10T19:00:00&#43;01:00">Oct 10 2016 7:00PM</time> <span class="schedule">Every Monday at 7:00PM</span> <span class="status">Cancelled</span></li>
+	</ul>
+*/
+func (a Agenda) WriteHTML(w io.Writer, after time.Time, n int) error {
+	rows, err := a.rows(after, n)
+	if err != nil {
+		return err
+	}
+	return htmlList.Execute(w, rows)
+}
+
+// rows formats the next n Entries
+func (a Agenda) rows(after time.Time, n int) ([]row, error) {
+	o := a.Options
+	entries, err := a.Entries(after, n)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]row, len(entries))
+	for i, e := range entries {
+		r := row{
+			Time:        e.Time,
+			Date:        o.FormatDate(e.Time),
+			Clock:       o.FormatTime(e.Time),
+			Description: e.Description,
+		}
+		switch {
+		case e.Cancelled:
+			r.Class, r.Status = "cancelled", o.Message(describe.MessageCancelled, 0)
+		case e.Moved():
+			r.Class, r.Status = "moved", o.Message(describe.MessageMovedTo, 0, "{first}", o.FormatDateTime(e.MovedTo))
+		case e.Added:
+			r.Class, r.Status = "added", o.Message(describe.MessageAdded, 0)
+		}
+		rows[i] = r
+	}
+	return rows, nil
+}
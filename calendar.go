@@ -0,0 +1,88 @@
+package meetingtime
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// The Gregorian calendar repeats every 400 years, including the days of the week,
+// since 146097 days is exactly 20871 weeks.
+const monthsPerCycle = 400 * 12
+
+// fifthWeekdays holds, for each day of the week, the number of months in a 400 year cycle
+// before each month that contain a fifth instance of that day.
+var fifthWeekdays struct {
+	once   sync.Once
+	prefix [7][monthsPerCycle + 1]int
+}
+
+func fifthWeekdayPrefix(weekday time.Weekday) *[monthsPerCycle + 1]int {
+	fifthWeekdays.once.Do(func() {
+		for m := 0; m < monthsPerCycle; m++ {
+			for w := time.Sunday; w <= time.Saturday; w++ {
+				fifthWeekdays.prefix[w][m+1] = fifthWeekdays.prefix[w][m]
+				if hasFifthWeekday(m, w) {
+					fifthWeekdays.prefix[w][m+1]++
+				}
+			}
+		}
+	})
+	return &fifthWeekdays.prefix[weekday]
+}
+
+// hasFifthWeekday returns true if the month with the given month number contains five instances of weekday.
+func hasFifthWeekday(month int, weekday time.Weekday) bool {
+	return weekdayOffset(month, weekday)+28 < daysInMonth(month)
+}
+
+// qualifyingMonths returns the number of months before the given month number that contain
+// an nth instance of weekday.
+func qualifyingMonths(weekday time.Weekday, n int, month int) int {
+	if n < 5 {
+		return month
+	}
+	prefix := fifthWeekdayPrefix(weekday)
+	return dates.FloorDiv(month, monthsPerCycle)*prefix[monthsPerCycle] + prefix[dates.Mod(month, monthsPerCycle)]
+}
+
+// qualifyingMonth returns the number of the month containing the qth nth instance of weekday,
+// acting as the inverse of qualifyingMonths.
+func qualifyingMonth(weekday time.Weekday, n int, q int) int {
+	if n < 5 {
+		return q
+	}
+	prefix := fifthWeekdayPrefix(weekday)
+	cycles := dates.FloorDiv(q, prefix[monthsPerCycle])
+	r := q - cycles*prefix[monthsPerCycle]
+	m := sort.Search(monthsPerCycle, func(m int) bool {
+		return prefix[m+1] > r
+	})
+	return cycles*monthsPerCycle + m
+}
+
+// nthWeekday returns the day of the month of the nth instance of weekday in the month with the given month number.
+func nthWeekday(month int, weekday time.Weekday, n int) int {
+	return 1 + weekdayOffset(month, weekday) + 7*(n-1)
+}
+
+// weekdayOffset returns the number of days from the start of the month with the given month number
+// until the first instance of weekday.
+func weekdayOffset(month int, weekday time.Weekday) int {
+	first := time.Date(dates.FloorDiv(month, 12), time.Month(dates.Mod(month, 12)+1), 1, 0, 0, 0, 0, time.UTC)
+	return dates.Mod(int(weekday)-int(first.Weekday()), 7)
+}
+
+// clampDay returns day, or the last day of the month with the given month number if it has fewer days.
+func clampDay(day, month int) int {
+	if days := daysInMonth(month); day > days {
+		return days
+	}
+	return day
+}
+
+func daysInMonth(month int) int {
+	return dates.DaysIn(dates.FloorDiv(month, 12), time.Month(dates.Mod(month, 12)+1))
+}
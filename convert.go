@@ -0,0 +1,149 @@
+package meetingtime
+
+import (
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// ConversionMode specifies how a Schedule is re-expressed in another time zone.
+type ConversionMode uint8
+
+const (
+	// KeepWallClock holds meetings on the same dates, at the same clock time, in the new time zone.
+	KeepWallClock ConversionMode = iota
+	// KeepInstant holds the first meeting at the same instant, with later meetings at the same
+	// clock time as the first in the new time zone.
+	KeepInstant
+)
+
+// ConversionWarning describes a way in which a converted Schedule differs from the original.
+type ConversionWarning uint8
+
+const (
+	// WeekdayChanged indicates that the first meeting falls on a different day of the week in the new time zone.
+	WeekdayChanged ConversionWarning = iota + 1
+	// DayOfMonthChanged indicates that the first meeting falls on a different day of the month in the new time zone.
+	DayOfMonthChanged
+	// OffsetsDiffer indicates that the time zones change their UTC offsets at different times of year,
+	// so not all meetings will be at the same instant as in the original Schedule.
+	OffsetsDiffer
+)
+
+func (w ConversionWarning) String() string {
+	switch w {
+	case WeekdayChanged:
+		return "first meeting is on a different day of the week"
+	case DayOfMonthChanged:
+		return "first meeting is on a different day of the month"
+	case OffsetsDiffer:
+		return "time zones have different daylight saving rules"
+	}
+	return "unknown warning"
+}
+
+/*
+Convert returns a copy of the Schedule with meetings held in a new time zone.
+
+With KeepWallClock, meetings keep their dates and clock times. With KeepInstant, the first meeting keeps
+its instant, and warnings are returned if this moves it to a different day in a way that affects the
+pattern of meetings, or if later meetings will not keep their instants due to differences in
+daylight saving rules.
+
+Floating schedules have no fixed instants, so can only be converted with KeepWallClock.
+*/
+func (s Schedule) Convert(loc *time.Location, mode ConversionMode) (Schedule, []ConversionWarning, error) {
+	if err := s.Validate(); err != nil {
+		return Schedule{}, nil, err
+	}
+	var warnings []ConversionWarning
+	switch mode {
+	case KeepWallClock:
+		s.First = sameClock(s.anchor(), loc)
+		if !s.Start.IsZero() {
+			start := s.Start
+			if !s.Floating {
+				start = start.In(s.location())
+			}
+			s.Start = sameClock(start, loc)
+		}
+	case KeepInstant:
+		if s.Floating {
+			return Schedule{}, nil, ErrFloatingInstant
+		}
+		from, to := s.anchor(), s.First.In(loc)
+		if from.Weekday() != to.Weekday() && (s.Type == Weekly || s.Type == MonthlyByWeekday) {
+			warnings = append(warnings, WeekdayChanged)
+		}
+		if from.Day() != to.Day() && s.Type != Daily && s.Type != Weekly {
+			warnings = append(warnings, DayOfMonthChanged)
+		}
+		if !sameOffsets(from, to) {
+			warnings = append(warnings, OffsetsDiffer)
+		}
+		s.First = to
+	default:
+		return Schedule{}, nil, ErrUnknownConversionMode
+	}
+	s.Location = loc
+	s.Floating = false
+	return s, warnings, nil
+}
+
+/*
+Convert returns a copy of the slice with each Schedule converted to a new time zone.
+The warnings returned cover all the Schedules, with each warning listed once.
+*/
+func (schedules ScheduleSlice) Convert(loc *time.Location, mode ConversionMode) (ScheduleSlice, []ConversionWarning, error) {
+	if len(schedules) == 0 {
+		return nil, nil, ErrEmptySchedule
+	}
+	var (
+		out      = make(ScheduleSlice, 0, len(schedules))
+		warnings []ConversionWarning
+		seen     = make(map[ConversionWarning]bool)
+	)
+	for _, s := range schedules {
+		c, w, err := s.Convert(loc, mode)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, c)
+		for _, warning := range w {
+			if !seen[warning] {
+				seen[warning] = true
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+	return out, warnings, nil
+}
+
+// sameClock returns the time in loc with the same date and clock time as t.
+func sameClock(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// sameOffsets returns true if the difference between the UTC offsets of a and b stays the same
+// for a year from the time of a.
+func sameOffsets(a, b time.Time) bool {
+	_, ao := a.Zone()
+	_, bo := b.Zone()
+	end := a.AddDate(1, 0, 0)
+	// The difference can only change when either time zone changes its offset
+	for _, t := range []time.Time{a, b} {
+		for {
+			transition := dates.NextTransition(t)
+			if transition.IsZero() || transition.After(end) {
+				break
+			}
+			_, at := transition.In(a.Location()).Zone()
+			_, bt := transition.In(b.Location()).Zone()
+			if at-bt != ao-bo {
+				return false
+			}
+			t = transition
+		}
+	}
+	return true
+}
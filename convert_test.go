@@ -0,0 +1,139 @@
+package meetingtime
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	newYork := mustLoadLocation(t, "America/New_York")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	var tests = []struct {
+		name             string
+		schedule         Schedule
+		loc              *time.Location
+		mode             ConversionMode
+		expectedFirst    time.Time
+		expectedNext     time.Time
+		expectedWarnings []ConversionWarning
+		expectedErr      error
+	}{
+		{
+			name:          "Wall clock",
+			schedule:      NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 1),
+			loc:           newYork,
+			mode:          KeepWallClock,
+			expectedFirst: time.Date(2016, time.January, 4, 9, 0, 0, 0, newYork),
+			expectedNext:  time.Date(2016, time.January, 11, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:          "Wall clock, floating",
+			schedule:      Schedule{Type: Daily, First: time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true},
+			loc:           tokyo,
+			mode:          KeepWallClock,
+			expectedFirst: time.Date(2016, time.January, 4, 9, 0, 0, 0, tokyo),
+			expectedNext:  time.Date(2016, time.January, 5, 9, 0, 0, 0, tokyo),
+		},
+		{
+			name:          "Instant",
+			schedule:      NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 1),
+			loc:           tokyo,
+			mode:          KeepInstant,
+			expectedFirst: time.Date(2016, time.January, 4, 18, 0, 0, 0, tokyo),
+			expectedNext:  time.Date(2016, time.January, 11, 18, 0, 0, 0, tokyo),
+			expectedWarnings: []ConversionWarning{
+				OffsetsDiffer,
+			},
+		},
+		{
+			name:          "Instant, zone without daylight saving",
+			schedule:      NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 1),
+			loc:           time.UTC,
+			mode:          KeepInstant,
+			expectedFirst: time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+			expectedNext:  time.Date(2016, time.January, 11, 9, 0, 0, 0, time.UTC),
+			expectedWarnings: []ConversionWarning{
+				OffsetsDiffer,
+			},
+		},
+		{
+			name:          "Instant, changes weekday",
+			schedule:      NewMonthlyScheduleByWeekday(time.Date(2016, time.January, 4, 2, 0, 0, 0, london)),
+			loc:           newYork,
+			mode:          KeepInstant,
+			expectedFirst: time.Date(2016, time.January, 3, 21, 0, 0, 0, newYork),
+			expectedNext:  time.Date(2016, time.February, 7, 21, 0, 0, 0, newYork),
+			expectedWarnings: []ConversionWarning{
+				WeekdayChanged,
+				DayOfMonthChanged,
+				OffsetsDiffer,
+			},
+		},
+		{
+			name:          "Instant, fixed zones",
+			schedule:      NewMonthlySchedule(time.Date(2016, time.January, 1, 2, 0, 0, 0, time.UTC), 1),
+			loc:           time.FixedZone("EST", -5*60*60),
+			mode:          KeepInstant,
+			expectedFirst: time.Date(2015, time.December, 31, 21, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			expectedNext:  time.Date(2016, time.January, 31, 21, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			expectedWarnings: []ConversionWarning{
+				DayOfMonthChanged,
+			},
+		},
+		{
+			name:        "Instant, floating",
+			schedule:    Schedule{Type: Daily, First: time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true},
+			loc:         tokyo,
+			mode:        KeepInstant,
+			expectedErr: ErrFloatingInstant,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			converted, warnings, err := test.schedule.Convert(test.loc, test.mode)
+			if err != test.expectedErr {
+				t.Fatalf("error: expected '%v' got '%v'", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(warnings, test.expectedWarnings) {
+				t.Errorf("warnings: expected %v got %v", test.expectedWarnings, warnings)
+			}
+			first, err := converted.OccurrenceAt(0)
+			if err != nil || !first.Equal(test.expectedFirst) {
+				t.Errorf("first: expected '%v' got '%v', %v", test.expectedFirst, first, err)
+			}
+			next, err := converted.Next(first)
+			if err != nil || !next.Equal(test.expectedNext) {
+				t.Errorf("next: expected '%v' got '%v', %v", test.expectedNext, next, err)
+			}
+		})
+	}
+}
+
+func TestConvertScheduleSlice(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 1, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 1, 0, 0, 0, time.UTC)),
+	}
+	converted, warnings, err := schedules.Convert(newYork, KeepInstant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedWarnings := []ConversionWarning{WeekdayChanged, DayOfMonthChanged, OffsetsDiffer}
+	if !reflect.DeepEqual(warnings, expectedWarnings) {
+		t.Errorf("warnings: expected %v got %v", expectedWarnings, warnings)
+	}
+	if len(converted) != len(schedules) {
+		t.Fatalf("expected %d schedules, got %d", len(schedules), len(converted))
+	}
+	for i, s := range converted {
+		if s.Location != newYork || !s.First.Equal(schedules[i].First) {
+			t.Errorf("schedule %d not converted: %v", i, s)
+		}
+	}
+}
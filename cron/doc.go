@@ -0,0 +1,9 @@
+/*
+Package cron converts Schedules from the meetingtime package to and from cron expressions.
+
+Both five-field expressions (minute, hour, day of month, month and day of week) and six-field expressions,
+with an initial field for seconds, are supported, along with the L, W and # extensions, and a CRON_TZ prefix
+giving the time zone. Expressions that cannot be expressed as Schedules, such as those on the last day of
+each month, can be evaluated directly with the Next and Previous methods of Expression.
+*/
+package cron
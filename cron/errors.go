@@ -0,0 +1,48 @@
+package cron
+
+import "fmt"
+
+type errorStr string
+
+func (e errorStr) Error() string { return string(e) }
+
+// ErrAnchoredFrequency indicates that a Schedule repeats every few days, weeks, months or years counted from its
+// first meeting. Cron expressions match dates by their fields alone, so can only express monthly frequencies that
+// divide the year evenly, such as every 3 months.
+const ErrAnchoredFrequency = errorStr("cron expressions cannot repeat at this frequency from a first meeting")
+
+// ErrShortMonth indicates that a Schedule has meetings on a day that some of its months do not have.
+// Schedules hold these meetings on the last day of the month, but cron expressions omit them.
+const ErrShortMonth = errorStr("meetings on days missing from some months cannot be expressed as a cron expression")
+
+// ErrDSTPolicy indicates that a Schedule's DSTPolicy affects its meetings, and differs from that of cron expressions,
+// which skip meetings at skipped times and hold meetings at repeated times at the earlier instant.
+const ErrDSTPolicy = errorStr("daylight saving policy cannot be expressed as a cron expression")
+
+// ErrFractionalSeconds indicates that a Schedule has meetings at fractions of a second, which cron expressions cannot express
+const ErrFractionalSeconds = errorStr("cron expressions cannot express fractions of a second")
+
+// ErrInvalid indicates that a cron expression could not be parsed.
+// It is wrapped by errors describing the problem in more detail.
+const ErrInvalid = errorStr("invalid cron expression")
+
+/*
+UnsupportedError reports a part of a cron expression that is valid, but cannot be evaluated by this package,
+or cannot be converted to a Schedule. Part identifies the field or value concerned, such as "@reboot" or "L".
+*/
+type UnsupportedError struct {
+	Part   string
+	Reason string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("unsupported %s: %s", e.Part, e.Reason)
+}
+
+func unsupported(part, reason string) error {
+	return &UnsupportedError{Part: part, Reason: reason}
+}
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalid}, args...)...)
+}
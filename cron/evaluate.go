@@ -0,0 +1,185 @@
+package cron
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// maxDays is the number of days searched for a matching date before giving up. The Gregorian calendar repeats
+// every 400 years, so an expression with no match in this time will never match.
+const maxDays = 400 * 366
+
+/*
+Next returns the first time after t matched by the Expression.
+
+Clock times skipped by a daylight saving transition are not matched, and clock times repeated by a transition
+are matched once, at the earlier instant. If the Expression can never match, such as on February 30th,
+meetingtime.ErrNoLaterMeetings is returned.
+*/
+func (e Expression) Next(t time.Time) (time.Time, error) {
+	if err := e.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	loc := e.location(t)
+	local := t.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	clocks := e.clocks()
+	for i := 0; i < maxDays; i++ {
+		d := day.AddDate(0, 0, i)
+		if !e.matchesDay(d) {
+			continue
+		}
+		for _, c := range clocks {
+			if next, ok := at(d, c, loc); ok && next.After(t) {
+				return next, nil
+			}
+		}
+	}
+	return time.Time{}, meetingtime.ErrNoLaterMeetings
+}
+
+/*
+Previous returns the last time before t matched by the Expression, handling daylight saving transitions as Next does.
+
+If the Expression can never match, meetingtime.ErrNoEarlierMeetings is returned.
+*/
+func (e Expression) Previous(t time.Time) (time.Time, error) {
+	if err := e.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	loc := e.location(t)
+	local := t.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	clocks := e.clocks()
+	for i := 0; i < maxDays; i++ {
+		d := day.AddDate(0, 0, -i)
+		if !e.matchesDay(d) {
+			continue
+		}
+		for j := len(clocks) - 1; j >= 0; j-- {
+			if previous, ok := at(d, clocks[j], loc); ok && previous.Before(t) {
+				return previous, nil
+			}
+		}
+	}
+	return time.Time{}, meetingtime.ErrNoEarlierMeetings
+}
+
+// location returns the time zone in which the Expression is evaluated for the given time.
+func (e Expression) location(t time.Time) *time.Location {
+	if e.Location != nil {
+		return e.Location
+	}
+	return t.Location()
+}
+
+// clocks returns the clock times matched by the Expression, in order, as seconds since midnight.
+func (e Expression) clocks() []int {
+	var clocks []int
+	for _, h := range sortedInts(e.Hours) {
+		for _, m := range sortedInts(e.Minutes) {
+			for _, s := range sortedInts(e.Seconds) {
+				clocks = append(clocks, h*3600+m*60+s)
+			}
+		}
+	}
+	return clocks
+}
+
+// matchesDay returns true if the Expression matches the date of d, which is given in UTC.
+func (e Expression) matchesDay(d time.Time) bool {
+	if e.Months != nil && !containsMonth(e.Months, d.Month()) {
+		return false
+	}
+	var day, weekday bool
+	for _, p := range e.Days {
+		day = day || p.matches(d)
+	}
+	for _, w := range e.Weekdays {
+		weekday = weekday || w.matches(d)
+	}
+	switch {
+	case e.Days != nil && e.Weekdays != nil:
+		return day || weekday
+	case e.Days != nil:
+		return day
+	case e.Weekdays != nil:
+		return weekday
+	}
+	return true
+}
+
+// matches returns true if the date d, given in UTC, is this day of the month.
+func (p Day) matches(d time.Time) bool {
+	last := dates.DaysIn(d.Year(), d.Month())
+	target := p.Day
+	if target <= 0 {
+		target += last
+	}
+	if target < 1 || target > last {
+		return false
+	}
+	if p.Weekday {
+		// Move to the nearest weekday without leaving the month
+		switch time.Date(d.Year(), d.Month(), target, 0, 0, 0, 0, time.UTC).Weekday() {
+		case time.Saturday:
+			if target--; target < 1 {
+				target += 3
+			}
+		case time.Sunday:
+			if target++; target > last {
+				target -= 3
+			}
+		}
+	}
+	return d.Day() == target
+}
+
+// matches returns true if the date d, given in UTC, is this day of the week.
+func (w Weekday) matches(d time.Time) bool {
+	if d.Weekday() != w.Day {
+		return false
+	}
+	switch {
+	case w.N > 0:
+		return (d.Day()-1)/7+1 == w.N
+	case w.N < 0:
+		return d.Day()+7 > dates.DaysIn(d.Year(), d.Month())
+	}
+	return true
+}
+
+// at returns the instant at the given clock time, in seconds since midnight, on the date d in loc, taking the
+// earlier instant if the clock time is repeated. If the clock time is skipped, ok will be false.
+func at(d time.Time, clock int, loc *time.Location) (t time.Time, ok bool) {
+	wall := d.Add(time.Duration(clock) * time.Second)
+	// Transitions are far enough apart that the offsets in effect a day either side of the clock time
+	// will be those either side of any transition affecting it.
+	guess := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, clock, 0, loc)
+	for _, near := range []time.Time{guess.Add(-24 * time.Hour), guess, guess.Add(24 * time.Hour)} {
+		_, offset := near.Zone()
+		u := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, o := u.Zone(); o == offset && (!ok || u.Before(t)) {
+			t, ok = u, true
+		}
+	}
+	return t, ok
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedInts(values []int) []int {
+	out := append([]int(nil), values...)
+	sort.Ints(out)
+	return out
+}
@@ -0,0 +1,203 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestNext(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	london := mustLoadLocation(t, "Europe/London")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	date := func(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+		return time.Date(year, month, day, hour, min, sec, 0, loc)
+	}
+	var tests = []struct {
+		name     string
+		in       string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			name: "Weekdays",
+			in:   "CRON_TZ=America/New_York 0 9 * * 1-5",
+			from: date(2016, time.September, 2, 10, 0, 0, newYork),
+			expected: []time.Time{
+				date(2016, time.September, 5, 9, 0, 0, newYork),
+				date(2016, time.September, 6, 9, 0, 0, newYork),
+				date(2016, time.September, 7, 9, 0, 0, newYork),
+			},
+		},
+		{
+			name: "Last day of the month",
+			in:   "0 0 L * *",
+			from: date(2016, time.January, 15, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.January, 31, 0, 0, 0, time.UTC),
+				date(2016, time.February, 29, 0, 0, 0, time.UTC),
+				date(2016, time.March, 31, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Days before the last day of the month",
+			in:   "0 0 L-2 * *",
+			from: date(2016, time.January, 15, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.January, 29, 0, 0, 0, time.UTC),
+				date(2016, time.February, 27, 0, 0, 0, time.UTC),
+				date(2016, time.March, 29, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Last weekday of the month",
+			in:   "0 0 LW * *",
+			from: date(2016, time.April, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.April, 29, 0, 0, 0, time.UTC),
+				date(2016, time.May, 31, 0, 0, 0, time.UTC),
+				date(2016, time.June, 30, 0, 0, 0, time.UTC),
+				date(2016, time.July, 29, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Nearest weekday to the 1st",
+			in:   "0 0 1W * *",
+			from: date(2016, time.September, 15, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.October, 3, 0, 0, 0, time.UTC),
+				date(2016, time.November, 1, 0, 0, 0, time.UTC),
+				date(2016, time.December, 1, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Nearest weekday to the 15th",
+			in:   "0 0 15W * *",
+			from: date(2016, time.October, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.October, 14, 0, 0, 0, time.UTC),
+				date(2016, time.November, 15, 0, 0, 0, time.UTC),
+				date(2016, time.December, 15, 0, 0, 0, time.UTC),
+				date(2017, time.January, 16, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Last Friday",
+			in:   "0 19 * * 5L",
+			from: date(2016, time.September, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.September, 30, 19, 0, 0, time.UTC),
+				date(2016, time.October, 28, 19, 0, 0, time.UTC),
+				date(2016, time.November, 25, 19, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Second Monday",
+			in:   "0 19 * * MON#2",
+			from: date(2016, time.September, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.September, 12, 19, 0, 0, time.UTC),
+				date(2016, time.October, 10, 19, 0, 0, time.UTC),
+				date(2016, time.November, 14, 19, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Day of month or day of week",
+			in:   "0 0 1 * MON",
+			from: date(2016, time.August, 20, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.August, 22, 0, 0, 0, time.UTC),
+				date(2016, time.August, 29, 0, 0, 0, time.UTC),
+				date(2016, time.September, 1, 0, 0, 0, time.UTC),
+				date(2016, time.September, 5, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Seconds",
+			in:   "*/20 0 9 * * *",
+			from: date(2016, time.January, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				date(2016, time.January, 1, 9, 0, 0, time.UTC),
+				date(2016, time.January, 1, 9, 0, 20, time.UTC),
+				date(2016, time.January, 1, 9, 0, 40, time.UTC),
+				date(2016, time.January, 2, 9, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Skipped time",
+			in:   "CRON_TZ=America/New_York 30 2 * * *",
+			from: date(2016, time.March, 12, 0, 0, 0, newYork),
+			expected: []time.Time{
+				date(2016, time.March, 12, 2, 30, 0, newYork),
+				date(2016, time.March, 14, 2, 30, 0, newYork),
+			},
+		},
+		{
+			name: "Repeated time",
+			in:   "CRON_TZ=America/New_York 30 1 * * *",
+			from: date(2016, time.November, 5, 12, 0, 0, newYork),
+			expected: []time.Time{
+				time.Date(2016, time.November, 6, 5, 30, 0, 0, time.UTC),
+				date(2016, time.November, 7, 1, 30, 0, newYork),
+			},
+		},
+		{
+			name: "Repeated time east of UTC",
+			in:   "CRON_TZ=Europe/London 30 1 * * *",
+			from: date(2016, time.October, 29, 12, 0, 0, london),
+			expected: []time.Time{
+				time.Date(2016, time.October, 30, 0, 30, 0, 0, time.UTC),
+				date(2016, time.October, 31, 1, 30, 0, london),
+			},
+		},
+		{
+			name: "Without a time zone",
+			in:   "0 9 * * *",
+			from: date(2016, time.January, 1, 12, 0, 0, tokyo),
+			expected: []time.Time{
+				date(2016, time.January, 2, 9, 0, 0, tokyo),
+				date(2016, time.January, 3, 9, 0, 0, tokyo),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next := test.from
+			for i, expected := range test.expected {
+				if next, err = e.Next(next); err != nil {
+					t.Fatal(err)
+				}
+				if !next.Equal(expected) {
+					t.Errorf("next %d: expected %v got %v", i, expected, next)
+				}
+			}
+			previous := next
+			for i := len(test.expected) - 2; i >= 0; i-- {
+				if previous, err = e.Previous(previous); err != nil {
+					t.Fatal(err)
+				}
+				if !previous.Equal(test.expected[i]) {
+					t.Errorf("previous %d: expected %v got %v", i, test.expected[i], previous)
+				}
+			}
+		})
+	}
+}
+
+func TestNextNever(t *testing.T) {
+	e, err := Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Next(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)); err != meetingtime.ErrNoLaterMeetings {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrNoLaterMeetings, err)
+	}
+	if _, err := e.Previous(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)); err != meetingtime.ErrNoEarlierMeetings {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrNoEarlierMeetings, err)
+	}
+}
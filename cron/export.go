@@ -0,0 +1,226 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+/*
+FromSchedule converts a Schedule into an Expression with the same meetings.
+
+Cron expressions match dates by their fields alone, so have no first meeting, and include meetings before the
+start of the Schedule. They also cannot count days, weeks or years from a first meeting, so a Schedule with a
+Frequency greater than 1 returns ErrAnchoredFrequency, unless it is a monthly schedule repeating evenly through
+the year, such as every 3 months. Other Schedules that cannot be expressed return an error explaining why,
+including monthly schedules on days that some months do not have, yearly schedules on February 29th, and
+schedules whose DSTPolicy affects their meetings in a way that differs from cron.
+*/
+func FromSchedule(schedule meetingtime.Schedule) (Expression, error) {
+	if err := schedule.Validate(); err != nil {
+		return Expression{}, err
+	}
+	loc := schedule.Location
+	if loc == nil {
+		loc = schedule.First.Location()
+	}
+	anchor := schedule.First
+	if !schedule.Floating {
+		anchor = anchor.In(loc)
+	}
+	if anchor.Nanosecond() != 0 {
+		return Expression{}, ErrFractionalSeconds
+	}
+
+	e := Expression{
+		Seconds: []int{anchor.Second()},
+		Minutes: []int{anchor.Minute()},
+		Hours:   []int{anchor.Hour()},
+	}
+	if !schedule.Floating {
+		e.Location = loc
+	}
+	anchored := schedule.Frequency > 1
+	switch schedule.Type {
+	case meetingtime.Daily:
+	case meetingtime.Weekly:
+		e.Weekdays = []Weekday{{Day: anchor.Weekday()}}
+	case meetingtime.Monthly:
+		if anchor.Day() > 28 {
+			return Expression{}, ErrShortMonth
+		}
+		e.Days = []Day{{Day: anchor.Day()}}
+		if anchored && 12%schedule.Frequency == 0 {
+			for m := 0; m < 12; m += int(schedule.Frequency) {
+				e.Months = append(e.Months, time.Month((int(anchor.Month())-1+m)%12+1))
+			}
+			anchored = false
+		}
+	case meetingtime.MonthlyByWeekday:
+		weekday, n := meetingtime.GetWeekdayAndIndex(anchor)
+		e.Weekdays = []Weekday{{Day: weekday, N: n}}
+		// Frequency is ignored
+		anchored = false
+	case meetingtime.Yearly:
+		if anchor.Month() == time.February && anchor.Day() == 29 {
+			return Expression{}, ErrShortMonth
+		}
+		e.Days = []Day{{Day: anchor.Day()}}
+		e.Months = []time.Month{anchor.Month()}
+	}
+	if anchored {
+		return Expression{}, ErrAnchoredFrequency
+	}
+	if !schedule.Floating {
+		if err := checkZone(schedule, anchor); err != nil {
+			return Expression{}, err
+		}
+	}
+	e.Months = sortMonths(e.Months)
+	return e, nil
+}
+
+/*
+FromScheduleSlice converts each Schedule in the slice with FromSchedule, and merges the results into as few
+Expressions as possible. Expressions are merged where they differ in a single field, so weekly schedules at
+9:00AM on Mondays and Wednesdays become "0 9 * * 1,3".
+*/
+func FromScheduleSlice(schedules meetingtime.ScheduleSlice) ([]Expression, error) {
+	if len(schedules) == 0 {
+		return nil, meetingtime.ErrEmptySchedule
+	}
+	var out []Expression
+	for i, s := range schedules {
+		e, err := FromSchedule(s)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %d: %w", i, err)
+		}
+		out = append(out, e)
+	}
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(out) && !merged; i++ {
+			for j := i + 1; j < len(out) && !merged; j++ {
+				if m, ok := merge(out[i], out[j]); ok {
+					out[i] = m
+					out = append(out[:j], out[j+1:]...)
+					merged = true
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+/*
+merge returns an Expression matching the times matched by either a or b, if one exists. This is the case
+if they differ in a single field, or if one gives only days of the month and the other only days of the week,
+as dates matching either day field are included.
+*/
+func merge(a, b Expression) (Expression, bool) {
+	if (a.Location == nil) != (b.Location == nil) || a.Location != nil && a.Location.String() != b.Location.String() {
+		return Expression{}, false
+	}
+	fa, fb := a.fields(), b.fields()
+	var differ []int
+	for i := range fa {
+		if fa[i] != fb[i] {
+			differ = append(differ, i)
+		}
+	}
+	m := a
+	switch {
+	case len(differ) == 2 && differ[0] == dayField && differ[1] == weekdayField:
+		switch {
+		case a.Days != nil && a.Weekdays == nil && b.Days == nil && b.Weekdays != nil:
+			m.Weekdays = b.Weekdays
+		case a.Days == nil && a.Weekdays != nil && b.Days != nil && b.Weekdays == nil:
+			m.Days = b.Days
+		default:
+			return Expression{}, false
+		}
+		return m, true
+	case len(differ) != 1:
+		return Expression{}, false
+	}
+	switch differ[0] {
+	case secondField:
+		m.Seconds = sortedInts(append(append([]int(nil), a.Seconds...), b.Seconds...))
+	case minuteField:
+		m.Minutes = sortedInts(append(append([]int(nil), a.Minutes...), b.Minutes...))
+	case hourField:
+		m.Hours = sortedInts(append(append([]int(nil), a.Hours...), b.Hours...))
+	case dayField:
+		if a.Days == nil || b.Days == nil {
+			return Expression{}, false
+		}
+		m.Days = sortDays(append(append([]Day(nil), a.Days...), b.Days...))
+	case monthField:
+		if a.Months == nil || b.Months == nil {
+			return Expression{}, false
+		}
+		m.Months = sortMonths(append(append([]time.Month(nil), a.Months...), b.Months...))
+	case weekdayField:
+		if a.Weekdays == nil || b.Weekdays == nil {
+			return Expression{}, false
+		}
+		m.Weekdays = sortWeekdays(append(append([]Weekday(nil), a.Weekdays...), b.Weekdays...))
+	}
+	return m, true
+}
+
+const (
+	secondField = iota
+	minuteField
+	hourField
+	dayField
+	monthField
+	weekdayField
+)
+
+// fields returns the formatted fields of the Expression, in order.
+func (e Expression) fields() [6]string {
+	return [6]string{
+		formatInts(sortedInts(e.Seconds), 0, 59, true),
+		formatInts(sortedInts(e.Minutes), 0, 59, true),
+		formatInts(sortedInts(e.Hours), 0, 23, true),
+		formatDays(e.Days),
+		formatMonths(e.Months),
+		formatWeekdays(e.Weekdays),
+	}
+}
+
+// sortMonths returns a sorted copy of months without duplicates.
+func sortMonths(months []time.Month) []time.Month {
+	if months == nil {
+		return nil
+	}
+	var out []time.Month
+	for m := time.January; m <= time.December; m++ {
+		if containsMonth(months, m) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// checkZone returns ErrDSTPolicy if the DSTPolicy of the Schedule changes its meetings around the daylight
+// saving transitions of its time zone, in a different way to cron.
+func checkZone(schedule meetingtime.Schedule, start time.Time) error {
+	if schedule.Gap == meetingtime.DSTSkip && schedule.Overlap == meetingtime.DSTShiftBack {
+		return nil
+	}
+	standard := schedule
+	standard.Gap = meetingtime.DSTSkip
+	standard.Overlap = meetingtime.DSTShiftBack
+	same, err := dates.SameAtTransitions(start, schedule.Next, standard.Next)
+	if err != nil {
+		return err
+	}
+	if !same {
+		return ErrDSTPolicy
+	}
+	return nil
+}
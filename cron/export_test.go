@@ -0,0 +1,234 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestFromSchedule(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	newYork := mustLoadLocation(t, "America/New_York")
+	var tests = []struct {
+		name        string
+		schedule    meetingtime.Schedule
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "Daily",
+			schedule: meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1),
+			expected: "CRON_TZ=UTC 0 9 * * *",
+		},
+		{
+			name:     "Weekly",
+			schedule: meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 30, 0, 0, london), 1),
+			expected: "CRON_TZ=Europe/London 30 9 * * 1",
+		},
+		{
+			name:     "Monthly",
+			schedule: meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 15, 9, 0, 0, 0, newYork), 1),
+			expected: "CRON_TZ=America/New_York 0 9 15 * *",
+		},
+		{
+			name:     "Every 3 months",
+			schedule: meetingtime.NewMonthlySchedule(time.Date(2016, time.February, 15, 9, 0, 0, 0, time.UTC), 3),
+			expected: "CRON_TZ=UTC 0 9 15 2,5,8,11 *",
+		},
+		{
+			name:     "Every 6 months",
+			schedule: meetingtime.NewMonthlySchedule(time.Date(2016, time.November, 1, 9, 0, 0, 0, time.UTC), 6),
+			expected: "CRON_TZ=UTC 0 9 1 5,11 *",
+		},
+		{
+			name:     "Monthly by weekday",
+			schedule: meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 12, 18, 30, 0, 0, london)),
+			expected: "CRON_TZ=Europe/London 30 18 * * 3#2",
+		},
+		{
+			name:     "Yearly",
+			schedule: meetingtime.NewYearlySchedule(time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC), 1),
+			expected: "CRON_TZ=UTC 0 0 1 3 *",
+		},
+		{
+			name:     "Seconds",
+			schedule: meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 30, 0, time.UTC), 1),
+			expected: "CRON_TZ=UTC 30 0 9 * * *",
+		},
+		{
+			name:     "Floating",
+			schedule: meetingtime.Schedule{Type: meetingtime.Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true},
+			expected: "0 9 * * *",
+		},
+		{
+			name:     "DST policy not affecting meetings",
+			schedule: meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, newYork), 1),
+			expected: "CRON_TZ=America/New_York 0 9 * * *",
+		},
+		{
+			name:     "Matching DST policy",
+			schedule: meetingtime.Schedule{Type: meetingtime.Daily, First: time.Date(2016, time.January, 4, 2, 30, 0, 0, newYork), Frequency: 1, Gap: meetingtime.DSTSkip},
+			expected: "CRON_TZ=America/New_York 30 2 * * *",
+		},
+		{
+			name:        "Every other day",
+			schedule:    meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			expectedErr: ErrAnchoredFrequency,
+		},
+		{
+			name:        "Every other week",
+			schedule:    meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			expectedErr: ErrAnchoredFrequency,
+		},
+		{
+			name:        "Every 5 months",
+			schedule:    meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 5),
+			expectedErr: ErrAnchoredFrequency,
+		},
+		{
+			name:        "Every 2 years",
+			schedule:    meetingtime.NewYearlySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			expectedErr: ErrAnchoredFrequency,
+		},
+		{
+			name:        "Monthly on the 31st",
+			schedule:    meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1),
+			expectedErr: ErrShortMonth,
+		},
+		{
+			name:        "Yearly on February 29th",
+			schedule:    meetingtime.NewYearlySchedule(time.Date(2016, time.February, 29, 9, 0, 0, 0, time.UTC), 1),
+			expectedErr: ErrShortMonth,
+		},
+		{
+			name:        "Fractional seconds",
+			schedule:    meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 500, time.UTC), 1),
+			expectedErr: ErrFractionalSeconds,
+		},
+		{
+			name:        "Different DST policy",
+			schedule:    meetingtime.NewDailySchedule(time.Date(2016, time.January, 4, 2, 30, 0, 0, newYork), 1),
+			expectedErr: ErrDSTPolicy,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, err := FromSchedule(test.schedule)
+			if err != test.expectedErr {
+				t.Fatalf("expected '%v' got '%v'", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if e.String() != test.expected {
+				t.Errorf("expected %q got %q", test.expected, e.String())
+			}
+
+			// The expression should have the same meetings as the schedule from its first meeting
+			next := test.schedule.First.Add(-time.Nanosecond)
+			expected := next
+			for i := 0; i < 50; i++ {
+				if next, err = e.Next(next); err != nil {
+					t.Fatal(err)
+				}
+				if expected, err = test.schedule.Next(expected); err != nil {
+					t.Fatal(err)
+				}
+				if !next.Equal(expected) {
+					t.Fatalf("meeting %d: expected %v got %v", i, expected, next)
+				}
+			}
+		})
+	}
+}
+
+func TestFromScheduleSlice(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.September, day, hour, 0, 0, 0, london)
+	}
+	var tests = []struct {
+		name      string
+		schedules meetingtime.ScheduleSlice
+		expected  []string
+	}{
+		{
+			name: "Days of the week",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(5, 9), 1),
+				meetingtime.NewWeeklySchedule(at(7, 9), 1),
+				meetingtime.NewWeeklySchedule(at(9, 9), 1),
+			},
+			expected: []string{"CRON_TZ=Europe/London 0 9 * * 1,3,5"},
+		},
+		{
+			name: "Times and days",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(5, 9), 1),
+				meetingtime.NewWeeklySchedule(at(5, 17), 1),
+				meetingtime.NewWeeklySchedule(at(6, 9), 1),
+				meetingtime.NewWeeklySchedule(at(6, 17), 1),
+			},
+			expected: []string{"CRON_TZ=Europe/London 0 9,17 * * 1,2"},
+		},
+		{
+			name: "1st and 3rd Monday",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(5, 19)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(19, 19)),
+			},
+			expected: []string{"CRON_TZ=Europe/London 0 19 * * 1#1,1#3"},
+		},
+		{
+			name: "Day of month and day of week",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(1, 9), 1),
+				meetingtime.NewWeeklySchedule(at(5, 9), 1),
+			},
+			expected: []string{"CRON_TZ=Europe/London 0 9 1 * 1"},
+		},
+		{
+			name: "Different times and days",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(5, 9), 1),
+				meetingtime.NewWeeklySchedule(at(6, 17), 1),
+			},
+			expected: []string{"CRON_TZ=Europe/London 0 9 * * 1", "CRON_TZ=Europe/London 0 17 * * 2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expressions, err := FromScheduleSlice(test.schedules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out []string
+			for _, e := range expressions {
+				out = append(out, e.String())
+			}
+			if len(out) != len(test.expected) {
+				t.Fatalf("expected %q got %q", test.expected, out)
+			}
+			for i := range out {
+				if out[i] != test.expected[i] {
+					t.Errorf("expected %q got %q", test.expected[i], out[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFromScheduleSliceErrors(t *testing.T) {
+	if _, err := FromScheduleSlice(nil); err != meetingtime.ErrEmptySchedule {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrEmptySchedule, err)
+	}
+	schedules := meetingtime.ScheduleSlice{
+		meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1),
+		meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 5, 9, 0, 0, 0, time.UTC), 2),
+	}
+	if _, err := FromScheduleSlice(schedules); !errors.Is(err, ErrAnchoredFrequency) {
+		t.Errorf("expected '%v' got '%v'", ErrAnchoredFrequency, err)
+	}
+}
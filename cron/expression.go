@@ -0,0 +1,257 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Expression is a parsed cron expression, matching the clock times made up of every combination of its Seconds,
+Minutes and Hours, on the dates matching its Days, Months and Weekdays.
+
+As with most cron implementations, if both Days and Weekdays are given, dates matching either are included.
+*/
+type Expression struct {
+	Location *time.Location // Time zone given by CRON_TZ. If nil, the time zone of the time passed to Next or Previous is used.
+	Seconds  []int          // Seconds past the minute. Five-field expressions have meetings at 0 seconds.
+	Minutes  []int          // Minutes past the hour
+	Hours    []int          // Hours of the day, from 0 to 23
+	Days     []Day          // Days of the month, or nil for any day
+	Months   []time.Month   // Months of the year, or nil for any month
+	Weekdays []Weekday      // Days of the week, or nil for any day of the week
+}
+
+// Day is a value from the day of month field of a cron expression.
+type Day struct {
+	Day     int  // Day of the month from 1 to 31, or if zero or negative, counting back from the last day, so 0 is L and -2 is L-2.
+	Weekday bool // If true, the weekday (Monday to Friday) nearest to Day in the same month, as with 15W or LW.
+}
+
+func (d Day) String() string {
+	var s string
+	switch {
+	case d.Day > 0:
+		s = strconv.Itoa(d.Day)
+	case d.Day == 0:
+		s = "L"
+	default:
+		s = fmt.Sprintf("L%d", d.Day)
+	}
+	if d.Weekday {
+		s += "W"
+	}
+	return s
+}
+
+// Weekday is a value from the day of week field of a cron expression.
+type Weekday struct {
+	Day time.Weekday
+	N   int // If positive, the Nth such day of the month, as with 1#2 for the 2nd Monday. If -1, the last such day of the month, as with 5L.
+}
+
+func (w Weekday) String() string {
+	switch {
+	case w.N > 0:
+		return fmt.Sprintf("%d#%d", w.Day, w.N)
+	case w.N < 0:
+		return fmt.Sprintf("%dL", w.Day)
+	}
+	return strconv.Itoa(int(w.Day))
+}
+
+/*
+String returns the Expression in cron syntax, with a CRON_TZ prefix if it has a Location other than time.Local.
+The seconds field is only included if the Expression has meetings at times other than 0 seconds past the minute.
+*/
+func (e Expression) String() string {
+	var fields []string
+	if len(e.Seconds) != 1 || e.Seconds[0] != 0 {
+		fields = append(fields, formatInts(e.Seconds, 0, 59, true))
+	}
+	fields = append(fields,
+		formatInts(e.Minutes, 0, 59, true),
+		formatInts(e.Hours, 0, 23, true),
+		formatDays(e.Days),
+		formatMonths(e.Months),
+		formatWeekdays(e.Weekdays),
+	)
+	s := strings.Join(fields, " ")
+	if e.Location != nil && e.Location != time.Local {
+		s = "CRON_TZ=" + e.Location.String() + " " + s
+	}
+	return s
+}
+
+// Validate checks that every field of the Expression has at least one value, and that values are in range.
+func (e Expression) Validate() error {
+	for _, f := range []struct {
+		name     string
+		values   []int
+		min, max int
+	}{
+		{"second", e.Seconds, 0, 59},
+		{"minute", e.Minutes, 0, 59},
+		{"hour", e.Hours, 0, 23},
+	} {
+		if len(f.values) == 0 {
+			return invalid("no %s given", f.name)
+		}
+		for _, v := range f.values {
+			if v < f.min || v > f.max {
+				return invalid("%s %d out of range", f.name, v)
+			}
+		}
+	}
+	for _, d := range e.Days {
+		if d.Day > 31 || d.Day < -30 {
+			return invalid("day of month %v out of range", d)
+		}
+		if d.Weekday && d.Day < 0 {
+			return invalid("day of month %v cannot be combined with W", d)
+		}
+	}
+	for _, m := range e.Months {
+		if m < time.January || m > time.December {
+			return invalid("month %d out of range", m)
+		}
+	}
+	for _, w := range e.Weekdays {
+		if w.Day < time.Sunday || w.Day > time.Saturday || w.N < -1 || w.N > 5 {
+			return invalid("day of week %v out of range", w)
+		}
+	}
+	return nil
+}
+
+// formatInts formats sorted values from a field ranging from min to max, using * for every value and */n for values
+// at regular steps from min if star is true, and ranges for runs of three or more values.
+func formatInts(values []int, min, max int, star bool) string {
+	if star && len(values) == max-min+1 {
+		return "*"
+	}
+	if star && len(values) > 1 && values[0] == min {
+		step := values[1] - values[0]
+		regular := step > 1 && values[len(values)-1]+step > max
+		for i := 1; i < len(values) && regular; i++ {
+			regular = values[i]-values[i-1] == step
+		}
+		if regular {
+			return fmt.Sprintf("*/%d", step)
+		}
+	}
+	var parts []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			parts = append(parts, fmt.Sprintf("%d-%d", values[i], values[j]))
+			i = j + 1
+			continue
+		}
+		parts = append(parts, strconv.Itoa(values[i]))
+		i++
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatDays formats the day of month field. Lists of days are never given as *, which would change
+// the meaning of the day of week field.
+func formatDays(days []Day) string {
+	if days == nil {
+		return "*"
+	}
+	var plain []int
+	var parts []string
+	for _, d := range sortDays(days) {
+		if d.Day > 0 && !d.Weekday {
+			plain = append(plain, d.Day)
+		} else {
+			parts = append(parts, d.String())
+		}
+	}
+	if len(plain) > 0 {
+		parts = append([]string{formatInts(plain, 1, 31, false)}, parts...)
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatMonths(months []time.Month) string {
+	if months == nil {
+		return "*"
+	}
+	values := make([]int, len(months))
+	for i, m := range months {
+		values[i] = int(m)
+	}
+	sort.Ints(values)
+	return formatInts(values, 1, 12, true)
+}
+
+// formatWeekdays formats the day of week field. As with days of the month, lists are never given as *.
+func formatWeekdays(weekdays []Weekday) string {
+	if weekdays == nil {
+		return "*"
+	}
+	var plain []int
+	var parts []string
+	for _, w := range sortWeekdays(weekdays) {
+		if w.N == 0 {
+			plain = append(plain, int(w.Day))
+		} else {
+			parts = append(parts, w.String())
+		}
+	}
+	if len(plain) > 0 {
+		parts = append([]string{formatInts(plain, 0, 6, false)}, parts...)
+	}
+	return strings.Join(parts, ",")
+}
+
+// sortDays returns a sorted copy of days without duplicates, with days counted from the start of the month first.
+func sortDays(days []Day) []Day {
+	out := append([]Day(nil), days...)
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if (a.Day > 0) != (b.Day > 0) {
+			return a.Day > 0
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return !a.Weekday && b.Weekday
+	})
+	var unique []Day
+	for i, d := range out {
+		if i == 0 || d != out[i-1] {
+			unique = append(unique, d)
+		}
+	}
+	return unique
+}
+
+// sortWeekdays returns a sorted copy of weekdays without duplicates, with every occurrence of a day first.
+func sortWeekdays(weekdays []Weekday) []Weekday {
+	out := append([]Weekday(nil), weekdays...)
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if (a.N == 0) != (b.N == 0) {
+			return a.N == 0
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.N < b.N
+	})
+	var unique []Weekday
+	for i, w := range out {
+		if i == 0 || w != out[i-1] {
+			unique = append(unique, w)
+		}
+	}
+	return unique
+}
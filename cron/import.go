@@ -0,0 +1,161 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// maxSchedules is the largest number of Schedules an Expression will be converted into.
+const maxSchedules = 24 * 60
+
+/*
+ScheduleSlice converts the Expression into a ScheduleSlice with the same meetings from the given time onwards,
+with a Schedule for each clock time, and each day of the week, month or year on which the Expression matches.
+For example, "0 9,17 * * MON,WED" becomes four weekly schedules.
+
+Days of the month are converted to monthly schedules where the day is in every month, and to yearly schedules
+otherwise, so "0 9 31 * *" becomes a yearly schedule for each month with 31 days. Monthly schedules with a
+Frequency are used where the months repeat evenly through the year, such as "0 9 1 1,4,7,10 *".
+
+The 5th day of the week in a month, such as "5#5", becomes a monthly schedule by weekday, with meetings only in the
+months that have one. Expressions using L or W, or that limit days of the week to some months, return an
+*UnsupportedError. Such Expressions can be evaluated directly with Next and Previous.
+
+The Schedules skip meetings at clock times skipped by daylight saving transitions, and hold meetings at repeated
+times at the earlier instant, as Next does. Expressions without a Location become floating Schedules.
+*/
+func (e Expression) ScheduleSlice(from time.Time) (meetingtime.ScheduleSlice, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	members, err := e.members()
+	if err != nil {
+		return nil, err
+	}
+	clocks := e.clocks()
+	if len(clocks)*len(members) > maxSchedules {
+		return nil, unsupported("expression", fmt.Sprintf("more than %d schedules would be needed", maxSchedules))
+	}
+
+	var schedules meetingtime.ScheduleSlice
+	for _, m := range members {
+		for _, c := range clocks {
+			sub := Expression{
+				Location: e.Location,
+				Seconds:  []int{c % 60},
+				Minutes:  []int{c / 60 % 60},
+				Hours:    []int{c / 3600},
+				Days:     m.days,
+				Months:   m.months,
+				Weekdays: m.weekdays,
+			}
+			first, err := sub.Next(from.Add(-time.Nanosecond))
+			if err == meetingtime.ErrNoLaterMeetings {
+				// Dates such as April 31st never occur
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			schedules = append(schedules, meetingtime.Schedule{
+				Type:      m.schedule,
+				First:     first,
+				Frequency: m.frequency,
+				Gap:       meetingtime.DSTSkip,
+				Overlap:   meetingtime.DSTShiftBack,
+				Floating:  e.Location == nil,
+			})
+		}
+	}
+	if len(schedules) == 0 {
+		return nil, invalid("expression has no meetings")
+	}
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return schedules[i].First.Before(schedules[j].First)
+	})
+	return schedules, nil
+}
+
+// member describes the dates of a Schedule needed to express an Expression, and the day fields matching them alone.
+type member struct {
+	schedule  meetingtime.ScheduleType
+	frequency uint
+	days      []Day
+	months    []time.Month
+	weekdays  []Weekday
+}
+
+// members returns the Schedules needed to express the dates matched by the Expression.
+func (e Expression) members() ([]member, error) {
+	allMonths := e.Months == nil || len(e.Months) == 12
+	if e.Days == nil && e.Weekdays == nil {
+		if !allMonths {
+			return nil, unsupported(formatMonths(e.Months), "schedules cannot hold meetings every day of some months")
+		}
+		return []member{{schedule: meetingtime.Daily, frequency: 1}}, nil
+	}
+
+	var members []member
+	for _, w := range e.Weekdays {
+		if !allMonths {
+			return nil, unsupported(formatMonths(e.Months), "schedules cannot hold meetings on days of the week in some months")
+		}
+		m := member{frequency: 1, weekdays: []Weekday{w}}
+		switch {
+		case w.N == 0:
+			m.schedule = meetingtime.Weekly
+		case w.N > 0 && w.N <= 5:
+			m.schedule = meetingtime.MonthlyByWeekday
+		default:
+			return nil, unsupported(w.String(), "schedules cannot hold meetings on the last day of the week in a month")
+		}
+		members = append(members, m)
+	}
+
+	months := e.Months
+	if months == nil {
+		months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	}
+	step, regular := monthStep(months)
+	for _, d := range e.Days {
+		if d.Day <= 0 || d.Weekday {
+			return nil, unsupported(d.String(), "schedules cannot hold meetings on the last day of the month, or the nearest weekday to a day")
+		}
+		if d.Day <= 28 && regular {
+			members = append(members, member{schedule: meetingtime.Monthly, frequency: step, days: []Day{d}, months: e.Months})
+			continue
+		}
+		// Hold a yearly meeting in each month with this day
+		for _, month := range months {
+			if month == time.February && d.Day == 29 {
+				return nil, unsupported(fmt.Sprintf("%d %v", d.Day, month), "schedules cannot hold meetings only in leap years")
+			}
+			if d.Day > dates.DaysIn(2001, month) {
+				continue
+			}
+			members = append(members, member{schedule: meetingtime.Yearly, frequency: 1, days: []Day{d}, months: []time.Month{month}})
+		}
+	}
+	return members, nil
+}
+
+// monthStep returns the number of months between each of the given months, if they are evenly spaced through
+// every year and there is more than one.
+func monthStep(months []time.Month) (uint, bool) {
+	if len(months) < 2 || 12%len(months) != 0 {
+		return 0, false
+	}
+	sorted := append([]time.Month(nil), months...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	step := 12 / len(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if int(sorted[i]-sorted[i-1]) != step {
+			return 0, false
+		}
+	}
+	return uint(step), true
+}
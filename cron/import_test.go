@@ -0,0 +1,109 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// Converted schedules should have the same meetings as the expression
+func TestScheduleSlice(t *testing.T) {
+	var tests = []struct {
+		in        string
+		schedules int
+	}{
+		{in: "0 9 * * *", schedules: 1},
+		{in: "0 9,17 * * MON,WED", schedules: 4},
+		{in: "30 19 * * MON#1,MON#3", schedules: 2},
+		{in: "0 9 * * 5#5", schedules: 1},
+		{in: "0 9 15 * *", schedules: 1},
+		{in: "0 9 1 */3 *", schedules: 1},
+		{in: "0 9 1 1,2,6 *", schedules: 3},
+		{in: "0 9 31 * *", schedules: 7},
+		{in: "0 0 1 * 5", schedules: 2},
+		{in: "CRON_TZ=America/New_York 30 2 * * *", schedules: 1},
+		{in: "CRON_TZ=Europe/London 30 1 * * SUN", schedules: 1},
+		{in: "CRON_TZ=Australia/Sydney 0 8 30 1,3 *", schedules: 2},
+		{in: "*/20 0 9 * * 1-5", schedules: 15},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			e, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			from := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+			schedules, err := e.ScheduleSlice(from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(schedules) != test.schedules {
+				t.Errorf("expected %d schedules, got %d", test.schedules, len(schedules))
+			}
+			expected, actual := from, from
+			for i := 0; i < 200; i++ {
+				if expected, err = e.Next(expected); err != nil {
+					t.Fatal(err)
+				}
+				if actual, err = schedules.Next(actual); err != nil {
+					t.Fatal(err)
+				}
+				if !actual.Equal(expected) {
+					t.Fatalf("meeting %d: expected %v got %v", i, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleSliceFloating(t *testing.T) {
+	e, err := Parse("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedules, err := e.ScheduleSlice(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !schedules[0].Floating {
+		t.Errorf("expected a floating schedule")
+	}
+}
+
+func TestScheduleSliceUnsupported(t *testing.T) {
+	var tests = []struct {
+		in   string
+		part string
+	}{
+		{in: "0 9 L * *", part: "L"},
+		{in: "0 9 15W * *", part: "15W"},
+		{in: "0 9 * * 5L", part: "5L"},
+		{in: "0 9 * 6 MON", part: "6"},
+		{in: "0 9 * 6-8 *", part: "6-8"},
+		{in: "0 9 29 * *", part: "29 February"},
+		{in: "* * * * * *", part: "expression"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			e, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = e.ScheduleSlice(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+			var u *UnsupportedError
+			if !errors.As(err, &u) || u.Part != test.part {
+				t.Errorf("expected %s to be unsupported, got '%v'", test.part, err)
+			}
+		})
+	}
+}
+
+func TestScheduleSliceNever(t *testing.T) {
+	e, err := Parse("0 9 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.ScheduleSlice(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrInvalid) {
+		t.Errorf("expected '%v' got '%v'", ErrInvalid, err)
+	}
+}
@@ -0,0 +1,239 @@
+package cron
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var weekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+/*
+Parse parses a cron expression with five fields (minute, hour, day of month, month and day of week), or six
+fields with seconds first, such as:
+
+	CRON_TZ=Europe/London 0 9 * * MON-FRI
+
+Fields may contain lists, ranges and steps, and months and days of the week may be given by their first three
+letters. Days of the week are numbered from 0 for Sunday, with 7 also meaning Sunday. The following extensions
+are supported:
+
+	L     in the day of month field, the last day of the month, or L-n for n days before it
+	W     in the day of month field, the nearest weekday to a day, such as 15W, or LW for the last weekday of the month
+	#     in the day of week field, the nth such day of the month, such as MON#2
+	L     in the day of week field, the last such day of the month, such as 5L or FRIL
+	?     in either day field, any day
+
+The macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly may also be used. An optional
+CRON_TZ= or TZ= prefix gives the IANA time zone in which the expression is evaluated.
+*/
+func Parse(text string) (Expression, error) {
+	var e Expression
+	fields := strings.Fields(text)
+	if len(fields) > 0 {
+		for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+			if name := strings.TrimPrefix(fields[0], prefix); name != fields[0] {
+				loc, err := time.LoadLocation(name)
+				if err != nil {
+					return Expression{}, invalid("unknown time zone %q", name)
+				}
+				e.Location = loc
+				fields = fields[1:]
+				break
+			}
+		}
+	}
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		macro, ok := macros[strings.ToLower(fields[0])]
+		if !ok {
+			return Expression{}, unsupported(fields[0], "only macros for regular times are supported")
+		}
+		fields = strings.Fields(macro)
+	}
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	case 7:
+		return Expression{}, unsupported("year", "expressions limited to some years are not supported")
+	default:
+		return Expression{}, invalid("expected 5 or 6 fields, got %d", len(fields))
+	}
+
+	var err error
+	if e.Seconds, err = parseInts("second", fields[0], 0, 59, nil); err != nil {
+		return Expression{}, err
+	}
+	if e.Minutes, err = parseInts("minute", fields[1], 0, 59, nil); err != nil {
+		return Expression{}, err
+	}
+	if e.Hours, err = parseInts("hour", fields[2], 0, 23, nil); err != nil {
+		return Expression{}, err
+	}
+	if e.Days, err = parseDays(fields[3]); err != nil {
+		return Expression{}, err
+	}
+	if fields[4] != "*" && fields[4] != "?" {
+		months, err := parseInts("month", fields[4], 1, 12, monthNames)
+		if err != nil {
+			return Expression{}, err
+		}
+		for _, m := range months {
+			e.Months = append(e.Months, time.Month(m))
+		}
+	}
+	if e.Weekdays, err = parseWeekdays(fields[5]); err != nil {
+		return Expression{}, err
+	}
+	return e, e.Validate()
+}
+
+// parseDays parses the day of month field, returning nil for any day.
+func parseDays(field string) ([]Day, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+	var days []Day
+	for _, item := range strings.Split(strings.ToUpper(field), ",") {
+		switch {
+		case item == "L":
+			days = append(days, Day{Day: 0})
+		case item == "LW":
+			days = append(days, Day{Day: 0, Weekday: true})
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 1 || n > 30 {
+				return nil, invalid("day of month %q", item)
+			}
+			days = append(days, Day{Day: -n})
+		case strings.HasSuffix(item, "W"):
+			n, err := strconv.Atoi(strings.TrimSuffix(item, "W"))
+			if err != nil || n < 1 || n > 31 {
+				return nil, invalid("day of month %q", item)
+			}
+			days = append(days, Day{Day: n, Weekday: true})
+		default:
+			values, err := parseInts("day of month", item, 1, 31, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range values {
+				days = append(days, Day{Day: v})
+			}
+		}
+	}
+	return sortDays(days), nil
+}
+
+// parseWeekdays parses the day of week field, returning nil for any day.
+func parseWeekdays(field string) ([]Weekday, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+	var weekdays []Weekday
+	for _, item := range strings.Split(strings.ToUpper(field), ",") {
+		if day, n, ok := strings.Cut(item, "#"); ok {
+			d, err := parseValue("day of week", day, 0, 7, weekdayNames)
+			if err != nil {
+				return nil, err
+			}
+			i, err := strconv.Atoi(n)
+			if err != nil || i < 1 || i > 5 {
+				return nil, invalid("day of week %q", item)
+			}
+			weekdays = append(weekdays, Weekday{Day: time.Weekday(d % 7), N: i})
+			continue
+		}
+		if day := strings.TrimSuffix(item, "L"); day != item && day != "" {
+			d, err := parseValue("day of week", day, 0, 7, weekdayNames)
+			if err != nil {
+				return nil, err
+			}
+			weekdays = append(weekdays, Weekday{Day: time.Weekday(d % 7), N: -1})
+			continue
+		}
+		values, err := parseInts("day of week", item, 0, 7, weekdayNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			weekdays = append(weekdays, Weekday{Day: time.Weekday(v % 7)})
+		}
+	}
+	return sortWeekdays(weekdays), nil
+}
+
+// parseInts parses a field made up of a list of values, ranges and steps, returning the sorted values without duplicates.
+func parseInts(name, field string, min, max int, names map[string]int) ([]int, error) {
+	seen := make(map[int]bool)
+	var values []int
+	for _, item := range strings.Split(strings.ToUpper(field), ",") {
+		span, stepText, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepText); err != nil || step < 1 {
+				return nil, invalid("%s step %q", name, stepText)
+			}
+		}
+		var from, to int
+		if span == "*" || span == "?" {
+			from, to = min, max
+		} else {
+			first, last, isRange := strings.Cut(span, "-")
+			var err error
+			if from, err = parseValue(name, first, min, max, names); err != nil {
+				return nil, err
+			}
+			to = from
+			if isRange {
+				if to, err = parseValue(name, last, min, max, names); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				to = max
+			}
+		}
+		if from > to {
+			return nil, invalid("%s range %q is backwards", name, span)
+		}
+		for v := from; v <= to; v += step {
+			if !seen[v] {
+				seen[v] = true
+				values = append(values, v)
+			}
+		}
+	}
+	sort.Ints(values)
+	return values, nil
+}
+
+// parseValue parses a single value from a field, given as a number or a name.
+func parseValue(name, text string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[text]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil || v < min || v > max {
+		return 0, invalid("%s %q", name, text)
+	}
+	return v, nil
+}
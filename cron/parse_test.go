@@ -0,0 +1,107 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestParse(t *testing.T) {
+	var tests = []struct {
+		in       string
+		expected string
+	}{
+		{in: "0 9 * * *", expected: "0 9 * * *"},
+		{in: "0 9 * * MON-FRI", expected: "0 9 * * 1-5"},
+		{in: "30 18 * * mon,wed", expected: "30 18 * * 1,3"},
+		{in: "*/15 9-17 * * 1-5", expected: "*/15 9-17 * * 1-5"},
+		{in: "0,15,30,45 */6 * * *", expected: "*/15 */6 * * *"},
+		{in: "0 0 1,15 1,4,7,10 ?", expected: "0 0 1,15 */3 *"},
+		{in: "0 0 1 JAN-MAR,DEC *", expected: "0 0 1 1-3,12 *"},
+		{in: "0 0 * * 7", expected: "0 0 * * 0"},
+		{in: "0 0 * * 0-7", expected: "0 0 * * 0-6"},
+		{in: "0 0 L * *", expected: "0 0 L * *"},
+		{in: "0 0 L-3 * *", expected: "0 0 L-3 * *"},
+		{in: "0 0 LW * *", expected: "0 0 LW * *"},
+		{in: "0 0 15W,1 * *", expected: "0 0 1,15W * *"},
+		{in: "0 19 ? * MON#1,MON#3", expected: "0 19 * * 1#1,1#3"},
+		{in: "0 19 * * FRIL", expected: "0 19 * * 5L"},
+		{in: "0 19 * * 5L", expected: "0 19 * * 5L"},
+		{in: "*/20 0 9 * * *", expected: "*/20 0 9 * * *"},
+		{in: "0 0 9 * * *", expected: "0 9 * * *"},
+		{in: "@weekly", expected: "0 0 * * 0"},
+		{in: "@hourly", expected: "0 * * * *"},
+		{in: "CRON_TZ=Europe/London 0 9 * * *", expected: "CRON_TZ=Europe/London 0 9 * * *"},
+		{in: "TZ=UTC @daily", expected: "CRON_TZ=UTC 0 0 * * *"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			e, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.String() != test.expected {
+				t.Errorf("expected %q got %q", test.expected, e.String())
+			}
+			// The formatted expression should parse to the same expression
+			again, err := Parse(e.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again.String() != e.String() {
+				t.Errorf("expected %q got %q", e.String(), again.String())
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	var tests = []struct {
+		in          string
+		unsupported string
+	}{
+		{in: ""},
+		{in: "0 9 * *"},
+		{in: "0 9 * * * * * *"},
+		{in: "60 9 * * *"},
+		{in: "0 24 * * *"},
+		{in: "0 9 0 * *"},
+		{in: "0 9 32 * *"},
+		{in: "0 9 * 13 *"},
+		{in: "0 9 * * 8"},
+		{in: "0 9 * * MON#6"},
+		{in: "0 9 * * MON#0"},
+		{in: "0 9 L-31 * *"},
+		{in: "0 9 32W * *"},
+		{in: "0 17-9 * * *"},
+		{in: "*/0 9 * * *"},
+		{in: "0 9 * * FUN"},
+		{in: "CRON_TZ=Nowhere/Special 0 9 * * *"},
+		{in: "@reboot", unsupported: "@reboot"},
+		{in: "0 0 9 * * * 2017", unsupported: "year"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			_, err := Parse(test.in)
+			var u *UnsupportedError
+			switch {
+			case test.unsupported != "":
+				if !errors.As(err, &u) || u.Part != test.unsupported {
+					t.Errorf("expected %s to be unsupported, got '%v'", test.unsupported, err)
+				}
+			case !errors.Is(err, ErrInvalid):
+				t.Errorf("expected '%v' got '%v'", ErrInvalid, err)
+			}
+		})
+	}
+}
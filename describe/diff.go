@@ -0,0 +1,232 @@
+package describe
+
+import (
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+/*
+Diff describes how meetings change when a schedule is edited, for notifying attendees.
+
+Changes describe the differences in the way meetings repeat, such as "moved from the 2nd Tuesday to the 2nd
+Wednesday" or "frequency changed from weekly to every other week". Removed and Added are the meetings within a window
+of time that no longer take place, or that now take place, and Description combines them all in a sentence.
+*/
+type Diff struct {
+	Changes     []string
+	Removed     []time.Time
+	Added       []time.Time
+	Description string
+}
+
+// DiffSchedules describes in English the differences between an old and an updated Schedule, with the meetings that
+// change between from and to
+func DiffSchedules(old, updated meetingtime.Schedule, from, to time.Time) (Diff, error) {
+	return Options{}.DiffSchedules(old, updated, from, to)
+}
+
+// DiffScheduleSlices describes in English the differences between an old and an updated ScheduleSlice, with the meetings
+// that change between from and to
+func DiffScheduleSlices(old, updated meetingtime.ScheduleSlice, from, to time.Time) (Diff, error) {
+	return Options{}.DiffScheduleSlices(old, updated, from, to)
+}
+
+// DiffSchedules describes the differences between an old and an updated Schedule, as DiffScheduleSlices does
+func (o Options) DiffSchedules(old, updated meetingtime.Schedule, from, to time.Time) (Diff, error) {
+	return o.DiffScheduleSlices(meetingtime.ScheduleSlice{old}, meetingtime.ScheduleSlice{updated}, from, to)
+}
+
+/*
+DiffScheduleSlices describes the differences between an old and an updated ScheduleSlice, with the meetings that
+change between from and to, inclusive. Windows holding more than 10000 meetings return ErrTooManyMeetings.
+
+Schedules are grouped as ScheduleSlice describes them, and groups are compared in the order of their first meetings.
+Where a group changes type, or the number of groups changes, the change is described in full, as in "changed from
+every Monday at 7:00PM to every 1st and 3rd Monday at 7:00PM".
+*/
+func (o Options) DiffScheduleSlices(old, updated meetingtime.ScheduleSlice, from, to time.Time) (Diff, error) {
+	oldParts, err := ScheduleSliceParts(old)
+	if err != nil {
+		return Diff{}, err
+	}
+	updatedParts, err := ScheduleSliceParts(updated)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	var diff Diff
+	if len(oldParts) == len(updatedParts) {
+		for i := range oldParts {
+			changes, err := o.changes(oldParts[i], updatedParts[i])
+			if err != nil {
+				return Diff{}, err
+			}
+			diff.Changes = append(diff.Changes, changes...)
+		}
+	} else {
+		change, err := o.changed(oldParts, updatedParts)
+		if err != nil {
+			return Diff{}, err
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+
+	oldMeetings, err := meetingsBetween(old, from, to)
+	if err != nil {
+		return Diff{}, err
+	}
+	updatedMeetings, err := meetingsBetween(updated, from, to)
+	if err != nil {
+		return Diff{}, err
+	}
+	diff.Removed = missingFrom(oldMeetings, updatedMeetings)
+	diff.Added = missingFrom(updatedMeetings, oldMeetings)
+
+	descriptions := diff.Changes
+	l := o.Locale
+	if len(diff.Removed) > 0 {
+		descriptions = append(descriptions, l.message(MessageRemovedMeetings, len(diff.Removed), "{dates}", l.list(o.formatDays(diff.Removed))))
+	}
+	if len(diff.Added) > 0 {
+		descriptions = append(descriptions, l.message(MessageAddedMeetings, len(diff.Added), "{dates}", l.list(o.formatDays(diff.Added))))
+	}
+	if len(descriptions) > 0 {
+		diff.Description = upperFirst(l.descriptions(descriptions))
+	}
+	return diff, nil
+}
+
+// changes describes the differences between Parts in the same position of the old and updated descriptions
+func (o Options) changes(old, updated Parts) ([]string, error) {
+	if old.Cadence != updated.Cadence {
+		change, err := o.changed([]Parts{old}, []Parts{updated})
+		return []string{change}, err
+	}
+	l := o.Locale
+	var changes []string
+	if old.Interval != updated.Interval {
+		changes = append(changes, l.message(MessageFrequencyChanged, 0,
+			"{old}", o.frequency(old), "{new}", o.frequency(updated)))
+	}
+	if oldDays, updatedDays := o.days(old), o.days(updated); oldDays != updatedDays {
+		changes = append(changes, l.message(MessageMoved, 0, "{old}", oldDays, "{new}", updatedDays))
+	}
+	if clockOf(old.Start) != clockOf(updated.Start) {
+		changes = append(changes, l.message(MessageTimeChanged, 0,
+			"{old}", o.formatTime(old.Start), "{new}", o.formatTime(updated.Start)))
+	}
+	if old.Zone != "" && updated.Zone != "" && old.Zone != updated.Zone {
+		changes = append(changes, l.message(MessageZoneChanged, 0, "{old}", old.Zone, "{new}", updated.Zone))
+	}
+	if len(changes) == 0 && !old.Unbounded && !updated.Unbounded && !old.Start.Equal(updated.Start) {
+		changes = append(changes, l.message(MessageStartChanged, 0,
+			"{old}", o.formatDate(old.Start), "{new}", o.formatDate(updated.Start)))
+	}
+	return changes, nil
+}
+
+// changed describes a change to the way meetings repeat in full
+func (o Options) changed(old, updated []Parts) (string, error) {
+	recurrence := Options{Locale: o.Locale, Clock24: o.Clock24, SpellOrdinals: o.SpellOrdinals, OmitStart: true}
+	describe := func(parts []Parts) (string, error) {
+		description, err := describeParts(defaultTemplate, recurrence, parts)
+		return lowerFirst(description), err
+	}
+	oldDescription, err := describe(old)
+	if err != nil {
+		return "", err
+	}
+	updatedDescription, err := describe(updated)
+	if err != nil {
+		return "", err
+	}
+	return o.Locale.message(MessageChanged, 0, "{old}", oldDescription, "{new}", updatedDescription), nil
+}
+
+// frequency describes how often meetings repeat, as in "every other week"
+func (o Options) frequency(p Parts) string {
+	id := map[string]string{
+		"daily":   MessageEveryDay,
+		"weekly":  MessageEveryWeek,
+		"monthly": MessageEveryMonth,
+		"yearly":  MessageEveryYear,
+	}[p.Cadence]
+	m, ok := o.Locale.Messages[id]
+	if !ok {
+		m = English.Messages[id]
+	}
+	if text, ok := m[Two]; ok && p.Interval == 2 {
+		return text
+	}
+	return o.Locale.message(id, p.Interval)
+}
+
+// days describes the days of meetings, as in "Tuesday" or "the 2nd Tuesday"
+func (o Options) days(p Parts) string {
+	l := o.Locale
+	switch p.Cadence {
+	case "weekly":
+		return l.list(mapStrings(len(p.Weekdays), func(i int) string { return l.weekday(p.Weekdays[i]) }))
+	case "monthly":
+		return l.message(MessageDaysOfMonth, len(p.Days),
+			"{days}", l.list(mapStrings(len(p.Days), func(i int) string { return l.monthDay(p.Days[i]) })))
+	case "monthly-by-weekday":
+		return l.message(MessageWeeksOfMonth, len(p.Ordinals),
+			"{ordinals}", l.list(mapStrings(len(p.Ordinals), func(i int) string { return o.ordinal(p.Ordinals[i]) })),
+			"{weekday}", l.weekday(p.Weekdays[0]))
+	case "yearly":
+		return o.formatMonthDay(p.Start)
+	}
+	return ""
+}
+
+// formatDays formats the days of meetings, with their years if they are not all in the same year
+func (o Options) formatDays(ts []time.Time) []string {
+	format := o.formatMonthDay
+	for _, t := range ts {
+		if t.Year() != ts[0].Year() {
+			format = o.formatDate
+		}
+	}
+	return mapStrings(len(ts), func(i int) string { return format(ts[i]) })
+}
+
+// maxMeetings is the greatest number of meetings compared by DiffScheduleSlices, enough for a daily schedule over
+// more than 25 years
+const maxMeetings = 10000
+
+// meetingsBetween returns the meetings of a ScheduleSlice from one time to another, inclusive, or ErrTooManyMeetings
+// if there are more than maxMeetings
+func meetingsBetween(schedules meetingtime.ScheduleSlice, from, to time.Time) ([]time.Time, error) {
+	var meetings []time.Time
+	for t := from.Add(-time.Nanosecond); ; {
+		next, err := schedules.Next(t)
+		if err != nil {
+			return nil, err
+		}
+		if next.After(to) {
+			return meetings, nil
+		}
+		if len(meetings) == maxMeetings {
+			return nil, ErrTooManyMeetings
+		}
+		meetings = append(meetings, next)
+		t = next
+	}
+}
+
+// missingFrom returns the meetings of a that are not in b, both of which are in order
+func missingFrom(a, b []time.Time) []time.Time {
+	var missing []time.Time
+	j := 0
+	for _, t := range a {
+		for j < len(b) && b[j].Before(t) {
+			j++
+		}
+		if j == len(b) || !b[j].Equal(t) {
+			missing = append(missing, t)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,183 @@
+package describe
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestDiffScheduleSlices(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2016, month, day, hour, minute, 0, 0, london)
+	}
+	from, to := at(time.October, 1, 0, 0), at(time.November, 1, 0, 0)
+	secondTuesday := meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 13, 19, 0))
+	secondWednesday := meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 14, 19, 0))
+	weekly := meetingtime.NewWeeklySchedule(at(time.September, 5, 19, 0), 1)
+	fortnightly := meetingtime.NewWeeklySchedule(at(time.September, 5, 19, 0), 2)
+	var tests = []struct {
+		name         string
+		options      Options
+		old, updated meetingtime.ScheduleSlice
+		expected     Diff
+		expectedErr  error
+	}{
+		{
+			name:    "Unchanged",
+			old:     meetingtime.ScheduleSlice{weekly},
+			updated: meetingtime.ScheduleSlice{weekly},
+		},
+		{
+			name:    "Moved",
+			old:     meetingtime.ScheduleSlice{secondTuesday},
+			updated: meetingtime.ScheduleSlice{secondWednesday},
+			expected: Diff{
+				Changes:     []string{"moved from the 2nd Tuesday to the 2nd Wednesday"},
+				Removed:     []time.Time{at(time.October, 11, 19, 0)},
+				Added:       []time.Time{at(time.October, 12, 19, 0)},
+				Description: "Moved from the 2nd Tuesday to the 2nd Wednesday; no meeting on Oct 11; and new meeting on Oct 12",
+			},
+		},
+		{
+			name:    "Frequency",
+			old:     meetingtime.ScheduleSlice{weekly},
+			updated: meetingtime.ScheduleSlice{fortnightly},
+			expected: Diff{
+				Changes:     []string{"frequency changed from weekly to every other week"},
+				Removed:     []time.Time{at(time.October, 10, 19, 0), at(time.October, 24, 19, 0)},
+				Description: "Frequency changed from weekly to every other week; and no meetings on Oct 10 and Oct 24",
+			},
+		},
+		{
+			name:    "Time",
+			old:     meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(at(time.September, 15, 19, 0), 1)},
+			updated: meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(at(time.September, 16, 18, 30), 1)},
+			expected: Diff{
+				Changes:     []string{"moved from the 15th to the 16th", "time changed from 7:00PM to 6:30PM"},
+				Removed:     []time.Time{at(time.October, 15, 19, 0)},
+				Added:       []time.Time{at(time.October, 16, 18, 30)},
+				Description: "Moved from the 15th to the 16th; time changed from 7:00PM to 6:30PM; no meeting on Oct 15; and new meeting on Oct 16",
+			},
+		},
+		{
+			name:    "First meeting",
+			old:     meetingtime.ScheduleSlice{fortnightly},
+			updated: meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(at(time.September, 12, 19, 0), 2)},
+			expected: Diff{
+				Changes:     []string{"first meeting changed from Sep 05 2016 to Sep 12 2016"},
+				Removed:     []time.Time{at(time.October, 3, 19, 0), at(time.October, 17, 19, 0), at(time.October, 31, 19, 0)},
+				Added:       []time.Time{at(time.October, 10, 19, 0), at(time.October, 24, 19, 0)},
+				Description: "First meeting changed from Sep 05 2016 to Sep 12 2016; no meetings on Oct 03, Oct 17 and Oct 31; and new meetings on Oct 10 and Oct 24",
+			},
+		},
+		{
+			name:    "Type",
+			old:     meetingtime.ScheduleSlice{weekly},
+			updated: meetingtime.ScheduleSlice{secondTuesday},
+			options: Options{
+				Clock24: true,
+			},
+			expected: Diff{
+				Changes: []string{"changed from every Monday at 19:00 to every 2nd Tuesday at 19:00"},
+				Removed: []time.Time{
+					at(time.October, 3, 19, 0), at(time.October, 10, 19, 0), at(time.October, 17, 19, 0),
+					at(time.October, 24, 19, 0), at(time.October, 31, 19, 0),
+				},
+				Added:       []time.Time{at(time.October, 11, 19, 0)},
+				Description: "Changed from every Monday at 19:00 to every 2nd Tuesday at 19:00; no meetings on Oct 03, Oct 10, Oct 17, Oct 24 and Oct 31; and new meeting on Oct 11",
+			},
+		},
+		{
+			name:    "Merged",
+			options: Options{Locale: French},
+			old:     meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 5, 19, 0))},
+			updated: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 5, 19, 0)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 19, 19, 0)),
+			},
+			expected: Diff{
+				Changes:     []string{"jour modifié : avant le 1er lundi, désormais les 1er et 3e lundi"},
+				Added:       []time.Time{at(time.October, 17, 19, 0)},
+				Description: "Jour modifié : avant le 1er lundi, désormais les 1er et 3e lundi ; et nouvelle réunion le 17 octobre",
+			},
+		},
+		{
+			name:    "German days of the month",
+			options: Options{Locale: German},
+			old:     meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(at(time.September, 1, 19, 0), 1)},
+			updated: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(time.September, 1, 19, 0), 1),
+				meetingtime.NewMonthlySchedule(at(time.September, 15, 19, 0), 1),
+			},
+			expected: Diff{
+				Changes:     []string{"Tag geändert: bisher am 1., jetzt am 1. und 15."},
+				Added:       []time.Time{at(time.October, 15, 19, 0)},
+				Description: "Tag geändert: bisher am 1., jetzt am 1. und 15.; und neues Treffen am 15. Oktober",
+			},
+		},
+		{
+			name:        "Empty",
+			old:         meetingtime.ScheduleSlice{weekly},
+			expectedErr: meetingtime.ErrEmptySchedule,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diff, err := test.options.DiffScheduleSlices(test.old, test.updated, from, to)
+			if err != test.expectedErr {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(diff, test.expected) {
+				t.Errorf("expected %#v, got %#v", test.expected, diff)
+			}
+		})
+	}
+}
+
+func TestDiffSchedules(t *testing.T) {
+	first := time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)
+	diff, err := DiffSchedules(
+		meetingtime.NewDailySchedule(first, 1),
+		meetingtime.NewDailySchedule(first, 3),
+		first, first.AddDate(0, 0, 3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "Frequency changed from daily to every 3 days; and no meetings on Sep 06 and Sep 07"
+	if diff.Description != expected {
+		t.Errorf("expected %q, got %q", expected, diff.Description)
+	}
+}
+
+func TestDiffTooManyMeetings(t *testing.T) {
+	first := time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)
+	daily := meetingtime.NewDailySchedule(first, 1)
+	if _, err := DiffSchedules(daily, daily, first, first.AddDate(0, 0, maxMeetings-1)); err != nil {
+		t.Errorf("expected no error for %d meetings, got %v", maxMeetings, err)
+	}
+	if _, err := DiffSchedules(daily, daily, first, first.AddDate(0, 0, maxMeetings)); err != ErrTooManyMeetings {
+		t.Errorf("expected %v, got %v", ErrTooManyMeetings, err)
+	}
+}
+
+func TestMissingFrom(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2016, time.September, d, 19, 0, 0, 0, time.UTC)
+	}
+	a := []time.Time{day(1), day(2), day(4), day(6), day(9)}
+	b := []time.Time{day(2), day(3), day(6), day(7), day(8)}
+	expected := []time.Time{day(1), day(4), day(9)}
+	if missing := missingFrom(a, b); !reflect.DeepEqual(missing, expected) {
+		t.Errorf("expected %v, got %v", expected, missing)
+	}
+	if missing := missingFrom(nil, b); missing != nil {
+		t.Errorf("expected no meetings, got %v", missing)
+	}
+}
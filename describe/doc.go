@@ -1,4 +1,19 @@
 /*
-Package describe provides tools to generate English descriptions of Schedules from the meetingtime package.
+Package describe provides tools to generate descriptions of Schedules from the meetingtime package.
+
+Descriptions are in English by default. Each Locale describes schedules in another language, with its own catalog of
+Messages, plural and ordinal rules, names of days and months, and date layouts. French, German and Spanish are
+provided, and further Locales can be added with Register and found by language tag with LookupLocale:
+
+	l, ok := describe.LookupLocale("fr-CA")
+	description, err := l.Schedule(schedule)
+
+Options adjust the formatting of descriptions, and can add the date of the last meeting and the time of meetings in
+the zones of viewers. The cancelled meetings of a Series are described as exceptions. A Template renders the Parts of
+a description with text/template for phrasing of your own. NextMeeting, PreviousMeeting and Relative describe
+meetings relative to the current time, such as "tomorrow at 7:00PM", in the time zone of the viewer.
+
+DiffSchedules and DiffScheduleSlices describe the changes made to a schedule, such as "moved from the 2nd Tuesday to
+the 2nd Wednesday", with the meetings that were removed or added within a window of time.
 */
 package describe
@@ -0,0 +1,101 @@
+package describe
+
+import "fmt"
+
+// English describes schedules in English. It is used by Schedule and ScheduleSlice, and provides anything
+// missing from other Locales.
+var English = Locale{
+	Tag: "en",
+	Messages: map[string]Message{
+		MessageDaily:                {One: "Every day starting {first}", Other: "Every {n} days starting {first}"},
+		MessageWeekly:               {One: "Every week starting {first}", Other: "Every {n} weeks starting {first}"},
+		MessageMonthly:              {One: "Every month starting {first}", Other: "Every {n} months starting {first}"},
+		MessageYearly:               {One: "Every year starting {first}", Other: "Every {n} years starting {first}"},
+		MessageMonthlyByWeekday:     {Other: "Every {ordinal} {weekday}, starting {first}"},
+		MessageWeeklyOn:             {One: "Every {days} at {time}", Other: "Every {n} weeks on {days} at {time}"},
+		MessageMonthlyOn:            {One: "Every month on the {days} at {time}", Other: "Every {n} months on the {days} at {time}"},
+		MessageMonthlyByWeekdays:    {Other: "Every {ordinals} {weekday} at {time}"},
+		MessageDailyAt:              {One: "Every day at {time}", Other: "Every {n} days at {time}"},
+		MessageYearlyOn:             {One: "Every year on {date} at {time}", Other: "Every {n} years on {date} at {time}"},
+		MessageStarting:             {Other: "{description}, starting {start}"},
+		MessageZone:                 {Other: "{description} ({zone})"},
+		MessageUntil:                {Other: "{description} until {end}"},
+		MessageExcept:               {Other: "{description} (except {dates})"},
+		MessageZoneTime:             {Other: "{time} {zone}"},
+		MessageZoneTimeNextDay:      {Other: "{time} {zone} (next day)"},
+		MessageZoneTimePreviousDay:  {Other: "{time} {zone} (previous day)"},
+		MessageZoneSeparator:        {Other: " / "},
+		MessageEveryDay:             {One: "daily", Two: "every other day", Other: "every {n} days"},
+		MessageEveryWeek:            {One: "weekly", Two: "every other week", Other: "every {n} weeks"},
+		MessageEveryMonth:           {One: "monthly", Two: "every other month", Other: "every {n} months"},
+		MessageEveryYear:            {One: "yearly", Two: "every other year", Other: "every {n} years"},
+		MessageDaysOfMonth:          {Other: "the {days}"},
+		MessageWeeksOfMonth:         {Other: "the {ordinals} {weekday}"},
+		MessageChanged:              {Other: "changed from {old} to {new}"},
+		MessageFrequencyChanged:     {Other: "frequency changed from {old} to {new}"},
+		MessageMoved:                {Other: "moved from {old} to {new}"},
+		MessageTimeChanged:          {Other: "time changed from {old} to {new}"},
+		MessageZoneChanged:          {Other: "time zone changed from {old} to {new}"},
+		MessageStartChanged:         {Other: "first meeting changed from {old} to {new}"},
+		MessageRemovedMeetings:      {One: "no meeting on {dates}", Other: "no meetings on {dates}"},
+		MessageAddedMeetings:        {One: "new meeting on {dates}", Other: "new meetings on {dates}"},
+		MessageToday:                {Other: "today at {time}"},
+		MessageTomorrow:             {Other: "tomorrow at {time}"},
+		MessageYesterday:            {Other: "yesterday at {time}"},
+		MessageThisWeek:             {Other: "this {weekday} at {time}"},
+		MessageNextWeek:             {Other: "next {weekday} at {time}"},
+		MessageLastWeek:             {Other: "last {weekday} at {time}"},
+		MessageInDays:               {One: "in {n} day ({day}) at {time}", Other: "in {n} days ({day}) at {time}"},
+		MessageDaysAgo:              {One: "{n} day ago ({day}) at {time}", Other: "{n} days ago ({day}) at {time}"},
+		MessageOn:                   {Other: "on {first}"},
+		MessageAgendaDate:           {Other: "Date"},
+		MessageAgendaTime:           {Other: "Time"},
+		MessageAgendaSchedule:       {Other: "Schedule"},
+		MessageAgendaStatus:         {Other: "Status"},
+		MessageCancelled:            {Other: "Cancelled"},
+		MessageMovedTo:              {Other: "Moved to {first}"},
+		MessageAdded:                {Other: "Added"},
+		MessageListSeparator:        {Other: ", "},
+		MessageListAnd:              {Other: " and "},
+		MessageDescriptionSeparator: {Other: "; "},
+		MessageDescriptionAnd:       {Other: "; and "},
+	},
+	Plural: func(n int) PluralCategory {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	Ordinal:  englishOrdinal,
+	MonthDay: englishOrdinal,
+
+	OrdinalWords: [5]string{"first", "second", "third", "fourth", "fifth"},
+
+	WeekdayDateTimeLayout: "Mon Jan 02 2006 at 3:04PM",
+	DateTimeLayout:        "Jan 02 2006 at 3:04PM",
+	DateLayout:            "Jan 02 2006",
+	MonthDayLayout:        "Jan 02",
+	TimeLayout:            "3:04PM",
+}
+
+func englishOrdinal(n int) string {
+	return fmt.Sprintf("%d%v", n, ordSuffix(n))
+}
+
+func ordSuffix(x int) string {
+	switch x % 10 {
+	case 1:
+		if x%100 != 11 {
+			return "st"
+		}
+	case 2:
+		if x%100 != 12 {
+			return "nd"
+		}
+	case 3:
+		if x%100 != 13 {
+			return "rd"
+		}
+	}
+	return "th"
+}
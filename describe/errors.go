@@ -0,0 +1,8 @@
+package describe
+
+type errorStr string
+
+func (e errorStr) Error() string { return string(e) }
+
+// ErrTooManyMeetings indicates that a diff was requested over a window of time holding too many meetings to compare
+const ErrTooManyMeetings = errorStr("too many meetings to compare")
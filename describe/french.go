@@ -0,0 +1,101 @@
+package describe
+
+import "fmt"
+
+// French describes schedules in French
+var French = Locale{
+	Tag: "fr",
+	Messages: map[string]Message{
+		MessageDaily:                {One: "Tous les jours à partir du {first}", Other: "Tous les {n} jours à partir du {first}"},
+		MessageWeekly:               {One: "Toutes les semaines à partir du {first}", Other: "Toutes les {n} semaines à partir du {first}"},
+		MessageMonthly:              {One: "Tous les mois à partir du {first}", Other: "Tous les {n} mois à partir du {first}"},
+		MessageYearly:               {One: "Tous les ans à partir du {first}", Other: "Tous les {n} ans à partir du {first}"},
+		MessageMonthlyByWeekday:     {Other: "Le {ordinal} {weekday} de chaque mois, à partir du {first}"},
+		MessageWeeklyOn:             {One: "Chaque {days} à {time}", Other: "Toutes les {n} semaines, le {days} à {time}"},
+		MessageMonthlyOn:            {One: "Tous les mois le {days} à {time}", Other: "Tous les {n} mois le {days} à {time}"},
+		MessageMonthlyByWeekdays:    {One: "Le {ordinals} {weekday} de chaque mois à {time}", Other: "Les {ordinals} {weekday} de chaque mois à {time}"},
+		MessageDailyAt:              {One: "Tous les jours à {time}", Other: "Tous les {n} jours à {time}"},
+		MessageYearlyOn:             {One: "Tous les ans le {date} à {time}", Other: "Tous les {n} ans le {date} à {time}"},
+		MessageStarting:             {Other: "{description}, à partir du {start}"},
+		MessageZone:                 {Other: "{description} ({zone})"},
+		MessageUntil:                {Other: "{description} jusqu'au {end}"},
+		MessageExcept:               {One: "{description} (sauf le {dates})", Other: "{description} (sauf les {dates})"},
+		MessageZoneTime:             {Other: "{time} {zone}"},
+		MessageZoneTimeNextDay:      {Other: "{time} {zone} (le lendemain)"},
+		MessageZoneTimePreviousDay:  {Other: "{time} {zone} (la veille)"},
+		MessageZoneSeparator:        {Other: " / "},
+		MessageEveryDay:             {One: "tous les jours", Two: "un jour sur deux", Other: "tous les {n} jours"},
+		MessageEveryWeek:            {One: "toutes les semaines", Two: "une semaine sur deux", Other: "toutes les {n} semaines"},
+		MessageEveryMonth:           {One: "tous les mois", Two: "un mois sur deux", Other: "tous les {n} mois"},
+		MessageEveryYear:            {One: "tous les ans", Two: "un an sur deux", Other: "tous les {n} ans"},
+		MessageDaysOfMonth:          {One: "le {days}", Other: "les {days}"},
+		MessageWeeksOfMonth:         {One: "le {ordinals} {weekday}", Other: "les {ordinals} {weekday}"},
+		MessageChanged:              {Other: "modifié : avant {old}, désormais {new}"},
+		MessageFrequencyChanged:     {Other: "fréquence modifiée : avant {old}, désormais {new}"},
+		MessageMoved:                {Other: "jour modifié : avant {old}, désormais {new}"},
+		MessageTimeChanged:          {Other: "heure modifiée : avant {old}, désormais {new}"},
+		MessageZoneChanged:          {Other: "fuseau horaire modifié : avant {old}, désormais {new}"},
+		MessageStartChanged:         {Other: "première réunion modifiée : avant le {old}, désormais le {new}"},
+		MessageRemovedMeetings:      {One: "pas de réunion le {dates}", Other: "pas de réunion les {dates}"},
+		MessageAddedMeetings:        {One: "nouvelle réunion le {dates}", Other: "nouvelles réunions les {dates}"},
+		MessageToday:                {Other: "aujourd'hui à {time}"},
+		MessageTomorrow:             {Other: "demain à {time}"},
+		MessageYesterday:            {Other: "hier à {time}"},
+		MessageThisWeek:             {Other: "ce {weekday} à {time}"},
+		MessageNextWeek:             {Other: "{weekday} prochain à {time}"},
+		MessageLastWeek:             {Other: "{weekday} dernier à {time}"},
+		MessageInDays:               {One: "dans {n} jour ({day}) à {time}", Other: "dans {n} jours ({day}) à {time}"},
+		MessageDaysAgo:              {One: "il y a {n} jour ({day}) à {time}", Other: "il y a {n} jours ({day}) à {time}"},
+		MessageOn:                   {Other: "le {first}"},
+		MessageAgendaDate:           {Other: "Date"},
+		MessageAgendaTime:           {Other: "Heure"},
+		MessageAgendaSchedule:       {Other: "Calendrier"},
+		MessageAgendaStatus:         {Other: "Statut"},
+		MessageCancelled:            {Other: "Annulée"},
+		MessageMovedTo:              {Other: "Déplacée au {first}"},
+		MessageAdded:                {Other: "Ajoutée"},
+		MessageListSeparator:        {Other: ", "},
+		MessageListAnd:              {Other: " et "},
+		MessageDescriptionSeparator: {Other: " ; "},
+		MessageDescriptionAnd:       {Other: " ; et "},
+	},
+	// Zero and one are singular in French
+	Plural: func(n int) PluralCategory {
+		if n == 0 || n == 1 {
+			return One
+		}
+		return Other
+	},
+	Ordinal:  frenchOrdinal,
+	MonthDay: frenchMonthDay,
+
+	Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc."},
+
+	OrdinalWords: [5]string{"premier", "deuxième", "troisième", "quatrième", "cinquième"},
+
+	WeekdayDateTimeLayout: "Monday 2 January 2006 à 15:04",
+	DateTimeLayout:        "2 January 2006 à 15:04",
+	DateLayout:            "2 January 2006",
+	MonthDayLayout:        "2 January",
+	TimeLayout:            "15:04",
+}
+
+func frenchOrdinal(n int) string {
+	if n == 1 {
+		return "1er"
+	}
+	return fmt.Sprintf("%de", n)
+}
+
+// frenchMonthDay numbers days of the month as cardinals, except for the first
+func frenchMonthDay(n int) string {
+	if n == 1 {
+		return "1er"
+	}
+	return fmt.Sprint(n)
+}
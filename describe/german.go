@@ -0,0 +1,89 @@
+package describe
+
+import "fmt"
+
+// German describes schedules in German
+var German = Locale{
+	Tag: "de",
+	Messages: map[string]Message{
+		MessageDaily:                {One: "Jeden Tag ab {first}", Other: "Alle {n} Tage ab {first}"},
+		MessageWeekly:               {One: "Jede Woche ab {first}", Other: "Alle {n} Wochen ab {first}"},
+		MessageMonthly:              {One: "Jeden Monat ab {first}", Other: "Alle {n} Monate ab {first}"},
+		MessageYearly:               {One: "Jedes Jahr ab {first}", Other: "Alle {n} Jahre ab {first}"},
+		MessageMonthlyByWeekday:     {Other: "Jeden {ordinal} {weekday} im Monat, ab {first}"},
+		MessageWeeklyOn:             {One: "Jeden {days} um {time}", Other: "Alle {n} Wochen am {days} um {time}"},
+		MessageMonthlyOn:            {One: "Jeden Monat am {days} um {time}", Other: "Alle {n} Monate am {days} um {time}"},
+		MessageMonthlyByWeekdays:    {Other: "Jeden {ordinals} {weekday} im Monat um {time}"},
+		MessageDailyAt:              {One: "Jeden Tag um {time}", Other: "Alle {n} Tage um {time}"},
+		MessageYearlyOn:             {One: "Jedes Jahr am {date} um {time}", Other: "Alle {n} Jahre am {date} um {time}"},
+		MessageStarting:             {Other: "{description}, ab {start}"},
+		MessageZone:                 {Other: "{description} ({zone})"},
+		MessageUntil:                {Other: "{description} bis {end}"},
+		MessageExcept:               {Other: "{description} (außer am {dates})"},
+		MessageZoneTime:             {Other: "{time} {zone}"},
+		MessageZoneTimeNextDay:      {Other: "{time} {zone} (am Folgetag)"},
+		MessageZoneTimePreviousDay:  {Other: "{time} {zone} (am Vortag)"},
+		MessageZoneSeparator:        {Other: " / "},
+		MessageEveryDay:             {One: "täglich", Two: "jeden zweiten Tag", Other: "alle {n} Tage"},
+		MessageEveryWeek:            {One: "wöchentlich", Two: "jede zweite Woche", Other: "alle {n} Wochen"},
+		MessageEveryMonth:           {One: "monatlich", Two: "jeden zweiten Monat", Other: "alle {n} Monate"},
+		MessageEveryYear:            {One: "jährlich", Two: "jedes zweite Jahr", Other: "alle {n} Jahre"},
+		MessageDaysOfMonth:          {Other: "am {days}"},
+		MessageWeeksOfMonth:         {Other: "am {ordinals} {weekday}"},
+		MessageChanged:              {Other: "geändert: bisher {old}, jetzt {new}"},
+		MessageFrequencyChanged:     {Other: "Häufigkeit geändert: bisher {old}, jetzt {new}"},
+		MessageMoved:                {Other: "Tag geändert: bisher {old}, jetzt {new}"},
+		MessageTimeChanged:          {Other: "Uhrzeit geändert: bisher {old}, jetzt {new}"},
+		MessageZoneChanged:          {Other: "Zeitzone geändert: bisher {old}, jetzt {new}"},
+		MessageStartChanged:         {Other: "erstes Treffen geändert: bisher am {old}, jetzt am {new}"},
+		MessageRemovedMeetings:      {One: "kein Treffen am {dates}", Other: "keine Treffen am {dates}"},
+		MessageAddedMeetings:        {One: "neues Treffen am {dates}", Other: "neue Treffen am {dates}"},
+		MessageToday:                {Other: "heute um {time}"},
+		MessageTomorrow:             {Other: "morgen um {time}"},
+		MessageYesterday:            {Other: "gestern um {time}"},
+		MessageThisWeek:             {Other: "diesen {weekday} um {time}"},
+		MessageNextWeek:             {Other: "nächsten {weekday} um {time}"},
+		MessageLastWeek:             {Other: "letzten {weekday} um {time}"},
+		MessageInDays:               {One: "in {n} Tag ({day}) um {time}", Other: "in {n} Tagen ({day}) um {time}"},
+		MessageDaysAgo:              {One: "vor {n} Tag ({day}) um {time}", Other: "vor {n} Tagen ({day}) um {time}"},
+		MessageOn:                   {Other: "am {first}"},
+		MessageAgendaDate:           {Other: "Datum"},
+		MessageAgendaTime:           {Other: "Uhrzeit"},
+		MessageAgendaSchedule:       {Other: "Termin"},
+		MessageAgendaStatus:         {Other: "Status"},
+		MessageCancelled:            {Other: "Abgesagt"},
+		MessageMovedTo:              {Other: "Verschoben auf {first}"},
+		MessageAdded:                {Other: "Hinzugefügt"},
+		MessageListSeparator:        {Other: ", "},
+		MessageListAnd:              {Other: " und "},
+		MessageDescriptionSeparator: {Other: "; "},
+		MessageDescriptionAnd:       {Other: "; und "},
+	},
+	Plural: func(n int) PluralCategory {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	Ordinal:  germanOrdinal,
+	MonthDay: germanOrdinal,
+
+	Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	Months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+		"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+
+	OrdinalWords: [5]string{"ersten", "zweiten", "dritten", "vierten", "fünften"},
+
+	WeekdayDateTimeLayout: "Monday, 2. January 2006 um 15:04 Uhr",
+	DateTimeLayout:        "2. January 2006 um 15:04 Uhr",
+	DateLayout:            "2. January 2006",
+	MonthDayLayout:        "2. January",
+	TimeLayout:            "15:04 Uhr",
+}
+
+func germanOrdinal(n int) string {
+	return fmt.Sprintf("%d.", n)
+}
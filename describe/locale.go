@@ -0,0 +1,360 @@
+package describe
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PluralCategory is a CLDR plural category, used to choose between the forms of a Message
+type PluralCategory string
+
+// Plural categories, as defined by the Unicode CLDR
+const (
+	Zero  PluralCategory = "zero"
+	One   PluralCategory = "one"
+	Two   PluralCategory = "two"
+	Few   PluralCategory = "few"
+	Many  PluralCategory = "many"
+	Other PluralCategory = "other"
+)
+
+/*
+Message holds the forms of a piece of text for each plural category of its count, such as "Every day" for One and
+"Every {n} days" for Other. Messages that do not vary need only an Other form, which is also used for categories
+without a form of their own.
+
+Messages contain placeholders in braces, which are replaced when a description is generated:
+
+	{n}           the frequency of the schedule
+	{first}       the date and time of the first meeting
+	{start}       the date of the first meeting, without the time
+	{date}        the day and month of meetings in every year
+	{time}        the time of day of meetings
+	{description} a description of a schedule, to be extended
+	{zone}        the name of the time zone of meetings
+	{end}         the date of the last meeting
+	{old}         a part of a schedule before it was changed
+	{new}         a part of a schedule after it was changed
+	{dates}       a list of dates of meetings that will not be held
+	{day}         the abbreviated day of the week of a meeting, as in "Tue"
+	{ordinal}     the week of the month of meetings, such as "2nd"
+	{ordinals}    a list of weeks of the month
+	{weekday}     the day of the week of meetings
+	{days}        a list of days of the week or month
+*/
+type Message map[PluralCategory]string
+
+// IDs of the Messages in a Locale's catalog
+const (
+	// MessageDaily describes a daily schedule, such as "Every day starting {first}"
+	MessageDaily = "daily"
+	// MessageWeekly describes a weekly schedule, such as "Every week starting {first}"
+	MessageWeekly = "weekly"
+	// MessageMonthly describes a monthly schedule, such as "Every month starting {first}"
+	MessageMonthly = "monthly"
+	// MessageYearly describes a yearly schedule, such as "Every year starting {first}"
+	MessageYearly = "yearly"
+	// MessageMonthlyByWeekday describes a monthly schedule by weekday, such as "Every {ordinal} {weekday}, starting {first}"
+	MessageMonthlyByWeekday = "monthlyByWeekday"
+	// MessageDailyAt describes a daily schedule without its first meeting, such as "Every day at {time}"
+	MessageDailyAt = "dailyAt"
+	// MessageWeeklyOn describes weekly schedules on one or more days, such as "Every {days} at {time}"
+	MessageWeeklyOn = "weeklyOn"
+	// MessageMonthlyOn describes monthly schedules on one or more days, such as "Every month on the {days} at {time}"
+	MessageMonthlyOn = "monthlyOn"
+	// MessageMonthlyByWeekdays describes monthly schedules on one or more weeks of the month, such as
+	// "Every {ordinals} {weekday} at {time}". Its count is the number of weeks, rather than the frequency.
+	MessageMonthlyByWeekdays = "monthlyByWeekdays"
+	// MessageYearlyOn describes a yearly schedule without its first meeting, such as "Every year on {date} at {time}"
+	MessageYearlyOn = "yearlyOn"
+	// MessageStarting adds the date of the first meeting to a description, such as "{description}, starting {start}"
+	MessageStarting = "starting"
+	// MessageZone adds the time zone of meetings to a description, such as "{description} ({zone})"
+	MessageZone = "zone"
+	// MessageUntil adds the date of the last meeting to a description, such as "{description} until {end}"
+	MessageUntil = "until"
+	// MessageExcept adds meetings that will not be held to a description, such as "{description} (except {dates})".
+	// Its count is the number of meetings.
+	MessageExcept = "except"
+	// MessageZoneTime gives the time of meetings in one of the zones of Options.Zones, such as "{time} {zone}"
+	MessageZoneTime = "zoneTime"
+	// MessageZoneTimeNextDay gives the time of meetings in a zone where they fall on the next day, such as
+	// "{time} {zone} (next day)"
+	MessageZoneTimeNextDay = "zoneTimeNextDay"
+	// MessageZoneTimePreviousDay gives the time of meetings in a zone where they fall on the previous day, such as
+	// "{time} {zone} (previous day)"
+	MessageZoneTimePreviousDay = "zoneTimePreviousDay"
+	// MessageZoneSeparator separates the times of meetings in each zone, such as " / "
+	MessageZoneSeparator = "zoneSeparator"
+	// MessageEveryDay describes how often daily meetings repeat, such as "every {n} days". A Two form, if given, is
+	// used for a frequency of 2 whatever the plural rules of the Locale, such as "every other day".
+	MessageEveryDay = "everyDay"
+	// MessageEveryWeek describes how often weekly meetings repeat, such as "every {n} weeks", with a Two form as for
+	// MessageEveryDay
+	MessageEveryWeek = "everyWeek"
+	// MessageEveryMonth describes how often monthly meetings repeat, such as "every {n} months", with a Two form as
+	// for MessageEveryDay
+	MessageEveryMonth = "everyMonth"
+	// MessageEveryYear describes how often yearly meetings repeat, such as "every {n} years", with a Two form as for
+	// MessageEveryDay
+	MessageEveryYear = "everyYear"
+	// MessageDaysOfMonth gives the days of the month of meetings, such as "the {days}". Its count is the number of days.
+	MessageDaysOfMonth = "daysOfMonth"
+	// MessageWeeksOfMonth gives the weeks of the month of meetings, such as "the {ordinals} {weekday}". Its count is
+	// the number of weeks.
+	MessageWeeksOfMonth = "weeksOfMonth"
+	// MessageChanged describes a change to the way meetings repeat, such as "changed from {old} to {new}"
+	MessageChanged = "changed"
+	// MessageFrequencyChanged describes a change to how often meetings repeat, such as
+	// "frequency changed from {old} to {new}"
+	MessageFrequencyChanged = "frequencyChanged"
+	// MessageMoved describes a change to the days of meetings, such as "moved from {old} to {new}"
+	MessageMoved = "moved"
+	// MessageTimeChanged describes a change to the time of meetings, such as "time changed from {old} to {new}"
+	MessageTimeChanged = "timeChanged"
+	// MessageZoneChanged describes a change to the time zone of meetings, such as
+	// "time zone changed from {old} to {new}"
+	MessageZoneChanged = "zoneChanged"
+	// MessageStartChanged describes a change to the date of the first meeting, such as
+	// "first meeting changed from {old} to {new}"
+	MessageStartChanged = "startChanged"
+	// MessageRemovedMeetings lists meetings that no longer take place, such as "no meetings on {dates}".
+	// Its count is the number of meetings.
+	MessageRemovedMeetings = "removedMeetings"
+	// MessageAddedMeetings lists meetings that now take place, such as "new meetings on {dates}".
+	// Its count is the number of meetings.
+	MessageAddedMeetings = "addedMeetings"
+	// MessageToday describes a meeting today, such as "today at {time}"
+	MessageToday = "today"
+	// MessageTomorrow describes a meeting tomorrow, such as "tomorrow at {time}"
+	MessageTomorrow = "tomorrow"
+	// MessageYesterday describes a meeting yesterday, such as "yesterday at {time}"
+	MessageYesterday = "yesterday"
+	// MessageThisWeek describes a meeting on a later day of this week, such as "this {weekday} at {time}"
+	MessageThisWeek = "thisWeek"
+	// MessageNextWeek describes a meeting next week, such as "next {weekday} at {time}"
+	MessageNextWeek = "nextWeek"
+	// MessageLastWeek describes a meeting last week, such as "last {weekday} at {time}"
+	MessageLastWeek = "lastWeek"
+	// MessageInDays describes a later meeting by counting days, such as "in {n} days ({day}) at {time}".
+	// Its count is the number of days.
+	MessageInDays = "inDays"
+	// MessageDaysAgo describes an earlier meeting by counting days, such as "{n} days ago ({day}) at {time}".
+	// Its count is the number of days.
+	MessageDaysAgo = "daysAgo"
+	// MessageOn describes a meeting by its date, such as "on {first}"
+	MessageOn = "on"
+	// MessageAgendaDate heads the column of dates of meetings in an agenda, such as "Date"
+	MessageAgendaDate = "agendaDate"
+	// MessageAgendaTime heads the column of times of meetings in an agenda, such as "Time"
+	MessageAgendaTime = "agendaTime"
+	// MessageAgendaSchedule heads the column of schedules of meetings in an agenda, such as "Schedule"
+	MessageAgendaSchedule = "agendaSchedule"
+	// MessageAgendaStatus heads the column of changes to meetings in an agenda, such as "Status"
+	MessageAgendaStatus = "agendaStatus"
+	// MessageCancelled marks a meeting in an agenda that will not be held, such as "Cancelled"
+	MessageCancelled = "cancelled"
+	// MessageMovedTo marks a meeting in an agenda that has been moved, such as "Moved to {first}", where {first} is
+	// the new date and time of the meeting
+	MessageMovedTo = "movedTo"
+	// MessageAdded marks a meeting in an agenda that is not on any schedule, such as "Added"
+	MessageAdded = "added"
+	// MessageListSeparator separates items in a list, other than the last two, such as ", "
+	MessageListSeparator = "listSeparator"
+	// MessageListAnd separates the last two items in a list, such as " and "
+	MessageListAnd = "listAnd"
+	// MessageDescriptionSeparator separates descriptions of unrelated schedules, other than the last two, such as "; "
+	MessageDescriptionSeparator = "descriptionSeparator"
+	// MessageDescriptionAnd separates the last two descriptions of unrelated schedules, such as "; and "
+	MessageDescriptionAnd = "descriptionAnd"
+)
+
+/*
+Locale holds the text and rules used to describe schedules in a language.
+
+Layouts are written as for the time package, with the names of days of the week and months (Monday, Mon, January
+and Jan) replaced by those of the Locale. Messages, rules, names and layouts missing from a Locale are taken from
+English, so a Locale may provide only what differs.
+*/
+type Locale struct {
+	// Tag is the BCP 47 language tag of the Locale, such as "fr" or "pt-BR"
+	Tag string
+	// Messages is the catalog of Messages by ID
+	Messages map[string]Message
+	// Plural returns the plural category of a count
+	Plural func(n int) PluralCategory
+	// Ordinal formats the week of the month, as in "2nd Tuesday"
+	Ordinal func(n int) string
+	// MonthDay formats a day of the month, as in "on the 15th"
+	MonthDay func(n int) string
+	// OrdinalWords spell out the weeks of the month, from "first" to "fifth"
+	OrdinalWords [5]string
+
+	// Weekdays and ShortWeekdays name the days of the week, starting with Sunday
+	Weekdays, ShortWeekdays [7]string
+	// Months and ShortMonths name the months, starting with January
+	Months, ShortMonths [12]string
+
+	// WeekdayDateTimeLayout formats the first meeting of a schedule, such as "Mon Jan 02 2006 at 3:04PM"
+	WeekdayDateTimeLayout string
+	// DateTimeLayout formats the first meeting of a schedule on a day of the week, such as "Jan 02 2006 at 3:04PM"
+	DateTimeLayout string
+	// DateLayout formats the date of the first meeting, such as "Jan 02 2006"
+	DateLayout string
+	// MonthDayLayout formats the day of meetings in every year, such as "Jan 02"
+	MonthDayLayout string
+	// TimeLayout formats the time of meetings, such as "3:04PM". Layouts are converted to a 24-hour clock
+	// for Options.Clock24 by replacing the hour and removing PM.
+	TimeLayout string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{}
+)
+
+func init() {
+	for _, l := range []Locale{English, French, German, Spanish} {
+		Register(l)
+	}
+}
+
+// Register makes a Locale available by its Tag to LookupLocale, replacing any Locale with the same Tag.
+// It panics if the Tag is empty.
+func Register(l Locale) {
+	if l.Tag == "" {
+		panic("describe: locale has no tag")
+	}
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[normalizeTag(l.Tag)] = l
+}
+
+// LookupLocale returns the registered Locale for a BCP 47 language tag, falling back to its language,
+// so "fr-CA" finds French unless a Locale for "fr-CA" is registered.
+func LookupLocale(tag string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tag = normalizeTag(tag)
+	for tag != "" {
+		if l, ok := locales[tag]; ok {
+			return l, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return Locale{}, false
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+// message returns the text of a Message for a count, with placeholders replaced by the given pairs of names and values
+func (l Locale) message(id string, n int, replacements ...string) string {
+	m, ok := l.Messages[id]
+	if !ok {
+		m = English.Messages[id]
+	}
+	plural := l.Plural
+	if plural == nil {
+		plural = English.Plural
+	}
+	text, ok := m[plural(n)]
+	if !ok {
+		text = m[Other]
+	}
+	replacements = append(replacements, "{n}", fmt.Sprint(n))
+	return strings.NewReplacer(replacements...).Replace(text)
+}
+
+func (l Locale) ordinal(n int) string {
+	if l.Ordinal == nil {
+		return English.Ordinal(n)
+	}
+	return l.Ordinal(n)
+}
+
+func (l Locale) monthDay(n int) string {
+	if l.MonthDay == nil {
+		return English.MonthDay(n)
+	}
+	return l.MonthDay(n)
+}
+
+func (l Locale) weekday(d time.Weekday) string {
+	return or(l.Weekdays[d], d.String())
+}
+
+// list joins items in a list, such as "Monday, Wednesday and Friday"
+func (l Locale) list(items []string) string {
+	if len(items) == 1 {
+		return items[0]
+	}
+	last := len(items) - 1
+	return strings.Join(items[:last], l.message(MessageListSeparator, 0)) + l.message(MessageListAnd, 0) + items[last]
+}
+
+// descriptions joins descriptions into a sentence, with later descriptions continuing it
+func (l Locale) descriptions(descriptions []string) string {
+	out := descriptions[0]
+	for i, d := range descriptions[1:] {
+		if i == len(descriptions)-2 {
+			out += l.message(MessageDescriptionAnd, 0)
+		} else {
+			out += l.message(MessageDescriptionSeparator, 0)
+		}
+		out += lowerFirst(d)
+	}
+	return out
+}
+
+var layoutNames = regexp.MustCompile(`Monday|Mon|January|Jan`)
+
+// format formats a time with a layout, using the Locale's names of days of the week and months
+func (l Locale) format(t time.Time, layout string) string {
+	var b strings.Builder
+	last := 0
+	for _, match := range layoutNames.FindAllStringIndex(layout, -1) {
+		b.WriteString(t.Format(layout[last:match[0]]))
+		name := layout[match[0]:match[1]]
+		switch name {
+		case "Monday":
+			b.WriteString(or(l.Weekdays[t.Weekday()], t.Format(name)))
+		case "Mon":
+			b.WriteString(or(l.ShortWeekdays[t.Weekday()], t.Format(name)))
+		case "January":
+			b.WriteString(or(l.Months[t.Month()-1], t.Format(name)))
+		case "Jan":
+			b.WriteString(or(l.ShortMonths[t.Month()-1], t.Format(name)))
+		}
+		last = match[1]
+	}
+	b.WriteString(t.Format(layout[last:]))
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+func or(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,216 @@
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestLocaleSchedule(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2016, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	var tests = []struct {
+		name        string
+		locale      Locale
+		schedule    meetingtime.Schedule
+		expectedOut string
+	}{
+		{
+			name:        "French daily",
+			locale:      French,
+			schedule:    meetingtime.NewDailySchedule(at(time.January, 1, 9), 1),
+			expectedOut: "Tous les jours à partir du vendredi 1 janvier 2016 à 09:00",
+		},
+		{
+			name:        "French every 2 weeks",
+			locale:      French,
+			schedule:    meetingtime.NewWeeklySchedule(at(time.February, 3, 18), 2),
+			expectedOut: "Toutes les 2 semaines à partir du mercredi 3 février 2016 à 18:00",
+		},
+		{
+			name:        "French 1st Friday",
+			locale:      French,
+			schedule:    meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 7, 19)),
+			expectedOut: "Le 1er vendredi de chaque mois, à partir du 7 octobre 2016 à 19:00",
+		},
+		{
+			name:        "German monthly",
+			locale:      German,
+			schedule:    meetingtime.NewMonthlySchedule(at(time.March, 15, 9), 1),
+			expectedOut: "Jeden Monat ab Dienstag, 15. März 2016 um 09:00 Uhr",
+		},
+		{
+			name:        "German every 6 months",
+			locale:      German,
+			schedule:    meetingtime.NewMonthlySchedule(at(time.March, 15, 9), 6),
+			expectedOut: "Alle 6 Monate ab Dienstag, 15. März 2016 um 09:00 Uhr",
+		},
+		{
+			name:        "German 2nd Wednesday",
+			locale:      German,
+			schedule:    meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 12, 19)),
+			expectedOut: "Jeden 2. Mittwoch im Monat, ab 12. Oktober 2016 um 19:00 Uhr",
+		},
+		{
+			name:        "Spanish yearly",
+			locale:      Spanish,
+			schedule:    meetingtime.NewYearlySchedule(at(time.August, 20, 10), 1),
+			expectedOut: "Todos los años a partir del sábado 20 de agosto de 2016 a las 10:00",
+		},
+		{
+			name:        "Spanish every 3 days",
+			locale:      Spanish,
+			schedule:    meetingtime.NewDailySchedule(at(time.August, 20, 10), 3),
+			expectedOut: "Cada 3 días a partir del sábado 20 de agosto de 2016 a las 10:00",
+		},
+		{
+			name:        "Spanish 3rd Monday",
+			locale:      Spanish,
+			schedule:    meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 17, 19)),
+			expectedOut: "El 3.º lunes de cada mes, a partir del 17 de octubre de 2016 a las 19:00",
+		},
+		{
+			name: "Partial locale",
+			locale: Locale{
+				Tag:                   "en-x-short",
+				Messages:              map[string]Message{MessageDaily: {Other: "Daily from {first}"}},
+				Months:                [12]string{"Jan.", "Feb.", "Mar.", "Apr.", "May", "Jun.", "Jul.", "Aug.", "Sept.", "Oct.", "Nov.", "Dec."},
+				WeekdayDateTimeLayout: "January 2, 2006",
+			},
+			schedule:    meetingtime.NewDailySchedule(at(time.September, 1, 9), 1),
+			expectedOut: "Daily from Sept. 1, 2016",
+		},
+		{
+			name: "Partial locale fallback",
+			locale: Locale{
+				Tag:      "en-x-short",
+				Messages: map[string]Message{MessageDaily: {Other: "Daily from {first}"}},
+			},
+			schedule:    meetingtime.NewWeeklySchedule(at(time.September, 1, 9), 2),
+			expectedOut: "Every 2 weeks starting Thu Sep 01 2016 at 9:00AM",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.locale.Schedule(test.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestLocaleScheduleSlice(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.September, day, hour, 0, 0, 0, time.UTC)
+	}
+	mondays := meetingtime.ScheduleSlice{
+		meetingtime.NewMonthlyScheduleByWeekday(at(5, 19)),
+		meetingtime.NewMonthlyScheduleByWeekday(at(19, 19)),
+	}
+	days := meetingtime.ScheduleSlice{
+		meetingtime.NewMonthlySchedule(at(1, 9), 1),
+		meetingtime.NewMonthlySchedule(at(15, 9), 1),
+	}
+	weekend := meetingtime.ScheduleSlice{
+		meetingtime.NewWeeklySchedule(at(10, 10), 1),
+		meetingtime.NewWeeklySchedule(at(11, 10), 1),
+	}
+	mixed := meetingtime.ScheduleSlice{
+		meetingtime.NewWeeklySchedule(at(5, 9), 1),
+		meetingtime.NewWeeklySchedule(at(7, 9), 1),
+		meetingtime.NewWeeklySchedule(at(9, 9), 1),
+		meetingtime.NewDailySchedule(at(10, 12), 1),
+	}
+	var tests = []struct {
+		name        string
+		locale      Locale
+		schedules   meetingtime.ScheduleSlice
+		expectedOut string
+	}{
+		{
+			name:        "French 1st and 3rd Monday",
+			locale:      French,
+			schedules:   mondays,
+			expectedOut: "Les 1er et 3e lundi de chaque mois à 19:00, à partir du 5 septembre 2016",
+		},
+		{
+			name:        "German 1st and 3rd Monday",
+			locale:      German,
+			schedules:   mondays,
+			expectedOut: "Jeden 1. und 3. Montag im Monat um 19:00 Uhr, ab 5. September 2016",
+		},
+		{
+			name:        "Spanish 1st and 3rd Monday",
+			locale:      Spanish,
+			schedules:   mondays,
+			expectedOut: "El 1.º y 3.º lunes de cada mes a las 19:00, a partir del 5 de septiembre de 2016",
+		},
+		{
+			name:        "French days of the month",
+			locale:      French,
+			schedules:   days,
+			expectedOut: "Tous les mois le 1er et 15 à 09:00, à partir du 1 septembre 2016",
+		},
+		{
+			name:        "German list",
+			locale:      German,
+			schedules:   mixed,
+			expectedOut: "Jeden Montag, Mittwoch und Freitag um 09:00 Uhr, ab 5. September 2016; und jeden Tag ab Samstag, 10. September 2016 um 12:00 Uhr",
+		},
+		{
+			name:        "Spanish list",
+			locale:      Spanish,
+			schedules:   mixed,
+			expectedOut: "Cada lunes, miércoles y viernes a las 09:00, a partir del 5 de septiembre de 2016; y todos los días a partir del sábado 10 de septiembre de 2016 a las 12:00",
+		},
+		{
+			name:        "Spanish weekend",
+			locale:      Spanish,
+			schedules:   weekend,
+			expectedOut: "Cada sábado y domingo a las 10:00, a partir del 10 de septiembre de 2016",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.locale.ScheduleSlice(test.schedules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	Register(Locale{Tag: "fr-CA", Messages: map[string]Message{MessageDaily: {Other: "Chaque jour"}}})
+	var tests = []struct {
+		tag      string
+		expected string
+		ok       bool
+	}{
+		{tag: "en", expected: "en", ok: true},
+		{tag: "fr", expected: "fr", ok: true},
+		{tag: "fr_FR", expected: "fr", ok: true},
+		{tag: "FR-ca", expected: "fr-CA", ok: true},
+		{tag: "de-AT", expected: "de", ok: true},
+		{tag: "es-419", expected: "es", ok: true},
+		{tag: "ja"},
+		{tag: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.tag, func(t *testing.T) {
+			l, ok := LookupLocale(test.tag)
+			if ok != test.ok || l.Tag != test.expected {
+				t.Errorf("expected %q (%v) got %q (%v)", test.expected, test.ok, l.Tag, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package describe
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// Options configures the descriptions generated by its Schedule and ScheduleSlice methods.
+// The zero Options generate the same descriptions as the Schedule and ScheduleSlice functions.
+type Options struct {
+	// Locale is the language of descriptions. The zero Locale describes schedules in English.
+	Locale Locale
+	// Clock24 formats times with a 24-hour clock, as in "19:00" rather than "7:00PM"
+	Clock24 bool
+	// OmitStart leaves out the date of the first meeting, as in "Every 2nd Wednesday at 7:00PM"
+	OmitStart bool
+	// SpellOrdinals spells out weeks of the month, as in "Every second Wednesday" rather than "Every 2nd Wednesday"
+	SpellOrdinals bool
+	// ShowZone adds the time zone of meetings, as in "(Europe/London)". Floating schedules have no time zone.
+	ShowZone bool
+	// End is the date of the last meeting, as in "until Dec 31 2026", or zero if meetings continue indefinitely.
+	// Schedules do not end, so it is set here rather than taken from them.
+	End time.Time
+	// Zones are the time zones of viewers, in which the time of meetings is given, as in
+	// "7:00PM London / 2:00PM New York". Floating schedules are described at their clock time alone.
+	Zones []*time.Location
+}
+
+// Schedule generates a description of an instance of meetingtime.Schedule
+func (o Options) Schedule(schedule meetingtime.Schedule) (string, error) {
+	return describeSchedule(defaultTemplate, o, schedule)
+}
+
+// ScheduleSlice generates a description of an instance of meetingtime.ScheduleSlice, merging Schedules as the
+// ScheduleSlice function does.
+func (o Options) ScheduleSlice(schedules meetingtime.ScheduleSlice) (string, error) {
+	return describeScheduleSlice(defaultTemplate, o, schedules)
+}
+
+// Series generates a description of the Schedules of an instance of meetingtime.Series, as ScheduleSlice does,
+// including the meetings that have been cancelled.
+func (o Options) Series(series meetingtime.Series) (string, error) {
+	return describeSeries(defaultTemplate, o, series)
+}
+
+// FormatDate formats the date of a meeting as descriptions do, as in "Jan 02 2006"
+func (o Options) FormatDate(t time.Time) string {
+	return o.formatDate(t)
+}
+
+// FormatTime formats the time of a meeting as descriptions do, as in "3:04PM"
+func (o Options) FormatTime(t time.Time) string {
+	return o.formatTime(t)
+}
+
+// FormatDateTime formats the date and time of a meeting as descriptions do, as in "Jan 02 2006 at 3:04PM"
+func (o Options) FormatDateTime(t time.Time) string {
+	return o.formatDateTime(t)
+}
+
+// Message returns the text of a Message in the Locale for a count, with its placeholders replaced by pairs of
+// placeholders and values, as in o.Message(MessageMovedTo, 0, "{first}", o.FormatDateTime(t))
+func (o Options) Message(id string, n int, replacements ...string) string {
+	return o.Locale.message(id, n, replacements...)
+}
+
+// ordinal formats the week of the month
+func (o Options) ordinal(n int) string {
+	if o.SpellOrdinals && n >= 1 && n <= 5 {
+		return or(o.Locale.OrdinalWords[n-1], English.OrdinalWords[n-1])
+	}
+	return o.Locale.ordinal(n)
+}
+
+func (o Options) formatWeekdayDateTime(t time.Time) string {
+	return o.Locale.format(t, o.layout(or(o.Locale.WeekdayDateTimeLayout, English.WeekdayDateTimeLayout)))
+}
+
+func (o Options) formatDateTime(t time.Time) string {
+	return o.Locale.format(t, o.layout(or(o.Locale.DateTimeLayout, English.DateTimeLayout)))
+}
+
+func (o Options) formatDate(t time.Time) string {
+	return o.Locale.format(t, or(o.Locale.DateLayout, English.DateLayout))
+}
+
+func (o Options) formatMonthDay(t time.Time) string {
+	return o.Locale.format(t, or(o.Locale.MonthDayLayout, English.MonthDayLayout))
+}
+
+func (o Options) formatTime(t time.Time) string {
+	return o.Locale.format(t, o.layout(or(o.Locale.TimeLayout, English.TimeLayout)))
+}
+
+/*
+zoneTimes formats the time of a meeting in each of the Zones, as in "7:00PM London / 2:00PM New York", noting where
+it falls on another day than in its own time zone.
+*/
+func (o Options) zoneTimes(t time.Time) string {
+	var times []string
+	for _, loc := range o.Zones {
+		zoned := t.In(loc)
+		id := MessageZoneTime
+		if days := dates.DayNumber(zoned) - dates.DayNumber(t); days > 0 {
+			id = MessageZoneTimeNextDay
+		} else if days < 0 {
+			id = MessageZoneTimePreviousDay
+		}
+		times = append(times, o.Locale.message(id, 0, "{time}", o.formatTime(zoned), "{zone}", zoneName(loc)))
+	}
+	return strings.Join(times, o.Locale.message(MessageZoneSeparator, 0))
+}
+
+// zoneName returns the city of an IANA time zone, as in "New York" for America/New_York
+func zoneName(loc *time.Location) string {
+	name := loc.String()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Replace(name, "_", " ", -1)
+}
+
+var (
+	hour12   = regexp.MustCompile(`(^|[^0-9])0?3([^0-9]|$)`)
+	meridiem = regexp.MustCompile(` ?(PM|pm)`)
+)
+
+// layout converts a layout to a 24-hour clock if needed, replacing the hour and removing AM/PM
+func (o Options) layout(layout string) string {
+	if !o.Clock24 {
+		return layout
+	}
+	return meridiem.ReplaceAllString(hour12.ReplaceAllString(layout, "${1}15${2}"), "")
+}
@@ -0,0 +1,269 @@
+package describe
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestOptionsSchedule(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2016, month, day, hour, 0, 0, 0, london)
+	}
+	secondWednesday := meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 12, 19))
+	var tests = []struct {
+		name        string
+		options     Options
+		schedule    meetingtime.Schedule
+		expectedOut string
+	}{
+		{
+			name:        "Default",
+			schedule:    secondWednesday,
+			expectedOut: "Every 2nd Wednesday, starting Oct 12 2016 at 7:00PM",
+		},
+		{
+			name:        "24-hour clock",
+			options:     Options{Clock24: true},
+			schedule:    meetingtime.NewWeeklySchedule(at(time.September, 5, 19), 1),
+			expectedOut: "Every week starting Mon Sep 05 2016 at 19:00",
+		},
+		{
+			name:        "24-hour clock in the morning",
+			options:     Options{Clock24: true, OmitStart: true},
+			schedule:    meetingtime.NewDailySchedule(at(time.September, 5, 9), 1),
+			expectedOut: "Every day at 09:00",
+		},
+		{
+			name:        "Spelled ordinals",
+			options:     Options{SpellOrdinals: true},
+			schedule:    secondWednesday,
+			expectedOut: "Every second Wednesday, starting Oct 12 2016 at 7:00PM",
+		},
+		{
+			name:        "Zone",
+			options:     Options{ShowZone: true},
+			schedule:    secondWednesday,
+			expectedOut: "Every 2nd Wednesday, starting Oct 12 2016 at 7:00PM (Europe/London)",
+		},
+		{
+			name:        "Floating without zone",
+			options:     Options{ShowZone: true},
+			schedule:    meetingtime.Schedule{Type: meetingtime.Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true},
+			expectedOut: "Every day starting Fri Jan 01 2016 at 9:00AM",
+		},
+		{
+			name:        "Without start, monthly by weekday",
+			options:     Options{OmitStart: true},
+			schedule:    secondWednesday,
+			expectedOut: "Every 2nd Wednesday at 7:00PM",
+		},
+		{
+			name:        "Without start, every 3 days",
+			options:     Options{OmitStart: true},
+			schedule:    meetingtime.NewDailySchedule(at(time.September, 5, 9), 3),
+			expectedOut: "Every 3 days at 9:00AM",
+		},
+		{
+			name:        "Without start, weekly",
+			options:     Options{OmitStart: true},
+			schedule:    meetingtime.NewWeeklySchedule(at(time.September, 5, 9), 1),
+			expectedOut: "Every Monday at 9:00AM",
+		},
+		{
+			name:        "Without start, every 2 weeks",
+			options:     Options{OmitStart: true},
+			schedule:    meetingtime.NewWeeklySchedule(at(time.September, 5, 9), 2),
+			expectedOut: "Every 2 weeks on Monday at 9:00AM",
+		},
+		{
+			name:        "Without start, monthly",
+			options:     Options{OmitStart: true},
+			schedule:    meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 1),
+			expectedOut: "Every month on the 15th at 9:00AM",
+		},
+		{
+			name:        "Without start, yearly",
+			options:     Options{OmitStart: true},
+			schedule:    meetingtime.NewYearlySchedule(at(time.January, 7, 0), 1),
+			expectedOut: "Every year on Jan 07 at 12:00AM",
+		},
+		{
+			name:        "French with all options",
+			options:     Options{Locale: French, Clock24: true, OmitStart: true, SpellOrdinals: true, ShowZone: true},
+			schedule:    secondWednesday,
+			expectedOut: "Le deuxième mercredi de chaque mois à 19:00 (Europe/London)",
+		},
+		{
+			name:        "German without start",
+			options:     Options{Locale: German, OmitStart: true},
+			schedule:    meetingtime.NewYearlySchedule(at(time.March, 3, 10), 2),
+			expectedOut: "Alle 2 Jahre am 3. März um 10:00 Uhr",
+		},
+		{
+			name:        "Spanish spelled ordinals",
+			options:     Options{Locale: Spanish, SpellOrdinals: true},
+			schedule:    secondWednesday,
+			expectedOut: "El segundo miércoles de cada mes, a partir del 12 de octubre de 2016 a las 19:00",
+		},
+		{
+			name:    "Location of meetings",
+			options: Options{ShowZone: true},
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.MonthlyByWeekday,
+				First:     time.Date(2016, time.October, 13, 2, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Location:  newYork,
+			},
+			expectedOut: "Every 2nd Wednesday, starting Oct 12 2016 at 10:00PM (America/New_York)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.options.Schedule(test.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestOptionsScheduleSlice(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedules := meetingtime.ScheduleSlice{
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, london)),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, london)),
+		meetingtime.NewDailySchedule(time.Date(2016, time.September, 1, 8, 30, 0, 0, time.UTC), 1),
+	}
+	var tests = []struct {
+		name        string
+		options     Options
+		expectedOut string
+	}{
+		{
+			name:        "Default",
+			expectedOut: "Every day starting Thu Sep 01 2016 at 8:30AM; and every 1st and 3rd Monday at 7:00PM, starting Sep 05 2016",
+		},
+		{
+			name:        "All options",
+			options:     Options{Clock24: true, OmitStart: true, SpellOrdinals: true, ShowZone: true},
+			expectedOut: "Every day at 08:30 (UTC); and every first and third Monday at 19:00 (Europe/London)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.options.ScheduleSlice(schedules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestOptionsEndsExceptionsAndZones(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekly := meetingtime.NewWeeklySchedule(time.Date(2016, time.September, 5, 19, 0, 0, 0, london), 1)
+	christmas := time.Date(2016, time.December, 26, 19, 0, 0, 0, london)
+	newYear := time.Date(2017, time.January, 2, 19, 0, 0, 0, london)
+	floating := weekly
+	floating.Floating = true
+	var tests = []struct {
+		name        string
+		options     Options
+		series      meetingtime.Series
+		expectedOut string
+	}{
+		{
+			name:        "End",
+			options:     Options{End: time.Date(2026, time.December, 31, 0, 0, 0, 0, london)},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}},
+			expectedOut: "Every week starting Mon Sep 05 2016 at 7:00PM until Dec 31 2026",
+		},
+		{
+			name:        "Exception",
+			options:     Options{OmitStart: true},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}, Cancelled: []time.Time{christmas}},
+			expectedOut: "Every Monday at 7:00PM (except Dec 26)",
+		},
+		{
+			name:        "Exceptions in different years",
+			options:     Options{OmitStart: true},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}, Cancelled: []time.Time{newYear, christmas}},
+			expectedOut: "Every Monday at 7:00PM (except Dec 26 2016 and Jan 02 2017)",
+		},
+		{
+			name:        "Exceptions after the end",
+			options:     Options{OmitStart: true, End: time.Date(2016, time.December, 31, 0, 0, 0, 0, london)},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}, Cancelled: []time.Time{christmas, newYear}},
+			expectedOut: "Every Monday at 7:00PM until Dec 31 2016 (except Dec 26)",
+		},
+		{
+			name:        "Zones",
+			options:     Options{Zones: []*time.Location{london, newYork}},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}},
+			expectedOut: "Every Monday at 7:00PM London / 2:00PM New York, starting Sep 05 2016",
+		},
+		{
+			name:        "Zones on the next day",
+			options:     Options{OmitStart: true, Clock24: true, Zones: []*time.Location{london, tokyo}},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{weekly}},
+			expectedOut: "Every Monday at 19:00 London / 03:00 Tokyo (next day)",
+		},
+		{
+			name:        "Zones with a floating schedule",
+			options:     Options{OmitStart: true, Zones: []*time.Location{london, newYork}},
+			series:      meetingtime.Series{Schedules: meetingtime.ScheduleSlice{floating}},
+			expectedOut: "Every Monday at 7:00PM",
+		},
+		{
+			name:    "French",
+			options: Options{Locale: French, OmitStart: true, End: time.Date(2026, time.December, 31, 0, 0, 0, 0, london)},
+			series: meetingtime.Series{
+				Schedules: meetingtime.ScheduleSlice{weekly},
+				Cancelled: []time.Time{christmas, time.Date(2016, time.December, 19, 19, 0, 0, 0, london)},
+			},
+			expectedOut: "Chaque lundi à 19:00 jusqu'au 31 décembre 2026 (sauf les 19 décembre et 26 décembre)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.options.Series(test.series)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("expected %q, got %q", test.expectedOut, out)
+			}
+		})
+	}
+}
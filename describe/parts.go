@@ -0,0 +1,158 @@
+package describe
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+/*
+Parts are the structured parts of a description of a Schedule, or of Schedules that repeat in the same way and are
+described in a single phrase, such as the 1st and 3rd Monday of each month. They are rendered by a Template.
+*/
+type Parts struct {
+	// Cadence is the type of the Schedules, as named by meetingtime.ScheduleType: "daily", "weekly", "monthly",
+	// "monthly-by-weekday" or "yearly"
+	Cadence string
+	// Interval is the number of days, weeks, months or years between meetings. It is 1 for monthly by weekday.
+	Interval int
+	// Weekdays are the days of the week of weekly meetings, from Monday, or the day of the week of monthly meetings
+	// by weekday
+	Weekdays []time.Weekday
+	// Ordinals are the weeks of the month of monthly meetings by weekday, in order
+	Ordinals []int
+	// Days are the days of the month of monthly meetings, in order
+	Days []int
+	// Start is the first meeting, which also gives the time of day of every meeting. For unbounded Schedules, which
+	// have no first meeting, it is the First meeting of their pattern.
+	Start time.Time
+	// Unbounded is true if the Schedules have no first meeting, so descriptions leave out when meetings start
+	Unbounded bool
+	// Zone is the name of the time zone of meetings, or empty for floating Schedules
+	Zone string
+	// End is the time of the last meeting, or zero if meetings continue indefinitely.
+	// Schedules do not end, so it is set from Options.End, or by callers.
+	End time.Time
+	// Exceptions are meetings that will not be held, such as the cancelled meetings of a Series
+	Exceptions []time.Time
+	// Schedules are the Schedules described
+	Schedules meetingtime.ScheduleSlice
+}
+
+// ScheduleParts returns the Parts of the description of a Schedule
+func ScheduleParts(schedule meetingtime.Schedule) (Parts, error) {
+	if err := schedule.Validate(); err != nil {
+		return Parts{}, err
+	}
+	return partsOf([]meetingtime.Schedule{local(schedule)}), nil
+}
+
+// ScheduleSliceParts returns the Parts of the description of a ScheduleSlice, with Schedules that repeat in the same
+// way merged into one Parts, as ScheduleSlice describes them
+func ScheduleSliceParts(schedules meetingtime.ScheduleSlice) ([]Parts, error) {
+	if err := schedules.Validate(); err != nil {
+		return nil, err
+	}
+	localSchedules := make(meetingtime.ScheduleSlice, len(schedules))
+	for i, s := range schedules {
+		localSchedules[i] = local(s)
+	}
+	var parts []Parts
+	for _, group := range mergeSchedules(localSchedules) {
+		parts = append(parts, partsOf(group))
+	}
+	return parts, nil
+}
+
+// SeriesParts returns the Parts of the description of the Schedules of a Series, as ScheduleSliceParts does, with
+// each cancelled meeting an Exception of the Parts of its Schedule
+func SeriesParts(series meetingtime.Series) ([]Parts, error) {
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+	parts, err := ScheduleSliceParts(series.Schedules)
+	if err != nil {
+		return nil, err
+	}
+	for _, cancelled := range series.Cancelled {
+		for i := range parts {
+			if parts[i].Schedules.IsOccurrence(cancelled) {
+				parts[i].Exceptions = append(parts[i].Exceptions, cancelled)
+				break
+			}
+		}
+	}
+	return parts, nil
+}
+
+// local returns a copy of a Schedule with First given in the time zone in which meetings are held, so the day and
+// time of meetings can be taken from it
+func local(schedule meetingtime.Schedule) meetingtime.Schedule {
+	if schedule.Location != nil && !schedule.Floating {
+		schedule.First = schedule.First.In(schedule.Location)
+	}
+	return schedule
+}
+
+// firstMeeting returns the time of the first meeting of a Schedule, at the time of day of First, taking Start into
+// account. Unbounded Schedules have no first meeting, so First is returned for them.
+func firstMeeting(s meetingtime.Schedule) time.Time {
+	f := s.First
+	if s.Unbounded && s.Start.IsZero() {
+		return f
+	}
+	// Meetings skipped under the Schedule's DSTPolicy are passed over, up to a limit for Schedules whose every
+	// meeting falls at a daylight saving transition
+	for n := 0; n < 100; n++ {
+		t, err := s.OccurrenceAt(n)
+		if err == meetingtime.ErrSkippedMeeting {
+			continue
+		}
+		if err != nil {
+			break
+		}
+		t = t.In(f.Location())
+		return time.Date(t.Year(), t.Month(), t.Day(), f.Hour(), f.Minute(), f.Second(), f.Nanosecond(), f.Location())
+	}
+	return f
+}
+
+// partsOf returns the Parts of Schedules merged by mergeSchedules
+func partsOf(group []meetingtime.Schedule) Parts {
+	start := group[0]
+	p := Parts{
+		Cadence:   start.Type.String(),
+		Interval:  int(start.Frequency),
+		Start:     firstMeeting(start),
+		Unbounded: start.Unbounded && start.Start.IsZero(),
+		Schedules: group,
+	}
+	if !start.Floating {
+		p.Zone = start.First.Location().String()
+	}
+	switch start.Type {
+	case meetingtime.Weekly:
+		for _, s := range group {
+			p.Weekdays = append(p.Weekdays, s.First.Weekday())
+		}
+		sort.Slice(p.Weekdays, func(i, j int) bool {
+			return (p.Weekdays[i]+6)%7 < (p.Weekdays[j]+6)%7
+		})
+	case meetingtime.Monthly:
+		for _, s := range group {
+			p.Days = append(p.Days, s.First.Day())
+		}
+		sort.Ints(p.Days)
+	case meetingtime.MonthlyByWeekday:
+		p.Interval = 1
+		weekday, _ := meetingtime.GetWeekdayAndIndex(start.First)
+		p.Weekdays = []time.Weekday{weekday}
+		for _, s := range group {
+			_, index := meetingtime.GetWeekdayAndIndex(s.First)
+			p.Ordinals = append(p.Ordinals, index)
+		}
+		sort.Ints(p.Ordinals)
+	}
+	return p
+}
@@ -0,0 +1,89 @@
+package describe
+
+import (
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// Meetings is implemented by meetingtime.Schedule, ScheduleSlice and Series
+type Meetings interface {
+	Next(t time.Time) (time.Time, error)
+	Previous(t time.Time) (time.Time, error)
+}
+
+// relativeDays is the number of days either side of now within which meetings are described by counting days
+const relativeDays = 28
+
+// NextMeeting describes the next meeting after now in English, relative to now, as Relative does
+func NextMeeting(meetings Meetings, now time.Time) (string, error) {
+	return Options{}.NextMeeting(meetings, now)
+}
+
+// PreviousMeeting describes the last meeting before now in English, relative to now, as Relative does
+func PreviousMeeting(meetings Meetings, now time.Time) (string, error) {
+	return Options{}.PreviousMeeting(meetings, now)
+}
+
+// Relative describes a meeting in English, relative to now, as Options.Relative does
+func Relative(meeting, now time.Time) string {
+	return Options{}.Relative(meeting, now)
+}
+
+// NextMeeting describes the next meeting after now, relative to now, as Relative does
+func (o Options) NextMeeting(meetings Meetings, now time.Time) (string, error) {
+	next, err := meetings.Next(now)
+	if err != nil {
+		return "", err
+	}
+	return o.Relative(next, now), nil
+}
+
+// PreviousMeeting describes the last meeting before now, relative to now, as Relative does
+func (o Options) PreviousMeeting(meetings Meetings, now time.Time) (string, error) {
+	previous, err := meetings.Previous(now)
+	if err != nil {
+		return "", err
+	}
+	return o.Relative(previous, now), nil
+}
+
+/*
+Relative describes a meeting relative to now, in the time zone of now, which is taken to be the viewer's. Meetings
+on the same day, the next day or the previous day are described as "today at 7:00PM", "tomorrow at 7:00PM" and
+"yesterday at 7:00PM". Other meetings are described by their day of the week later in the same week ("this Thursday
+at 7:00PM"), the next week ("next Tuesday at 7:00PM") or the previous week ("last Tuesday at 7:00PM"), where weeks
+start on Monday. Meetings earlier in the same week, and others within 4 weeks, are described by counting days
+("in 12 days (Sun) at 7:00PM" or "12 days ago (Sun) at 7:00PM"), and later or earlier meetings by their date
+("on Mon Jan 02 2017 at 7:00PM").
+*/
+func (o Options) Relative(meeting, now time.Time) string {
+	l := o.Locale
+	meeting = meeting.In(now.Location())
+	days := dates.DayNumber(meeting) - dates.DayNumber(now)
+	weeks := (weekStart(meeting) - weekStart(now)) / 7
+	at := []string{
+		"{time}", o.formatTime(meeting),
+		"{weekday}", l.weekday(meeting.Weekday()),
+		"{day}", or(l.ShortWeekdays[meeting.Weekday()], meeting.Format("Mon")),
+	}
+	switch {
+	case days == 0:
+		return l.message(MessageToday, 0, at...)
+	case days == 1:
+		return l.message(MessageTomorrow, 0, at...)
+	case days == -1:
+		return l.message(MessageYesterday, 0, at...)
+	case weeks == 0 && days > 0:
+		return l.message(MessageThisWeek, 0, at...)
+	case weeks == 1:
+		return l.message(MessageNextWeek, 0, at...)
+	case weeks == -1:
+		return l.message(MessageLastWeek, 0, at...)
+	case days > 0 && days < relativeDays:
+		return l.message(MessageInDays, days, at...)
+	case days < 0 && days > -relativeDays:
+		return l.message(MessageDaysAgo, -days, at...)
+	}
+	return l.message(MessageOn, 0, "{first}", o.formatWeekdayDateTime(meeting))
+}
@@ -0,0 +1,104 @@
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestRelative(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Thursday
+	now := time.Date(2016, time.September, 8, 12, 0, 0, 0, time.UTC)
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.September, day, hour, 0, 0, 0, time.UTC)
+	}
+	var tests = []struct {
+		name        string
+		options     Options
+		meeting     time.Time
+		now         time.Time
+		expectedOut string
+	}{
+		{name: "Today", meeting: at(8, 19), now: now, expectedOut: "today at 7:00PM"},
+		{name: "Tomorrow", meeting: at(9, 19), now: now, expectedOut: "tomorrow at 7:00PM"},
+		{name: "Yesterday", meeting: at(7, 19), now: now, expectedOut: "yesterday at 7:00PM"},
+		{name: "This week", meeting: at(11, 19), now: now, expectedOut: "this Sunday at 7:00PM"},
+		{name: "Earlier this week", meeting: at(5, 19), now: now, expectedOut: "3 days ago (Mon) at 7:00PM"},
+		{name: "Next week", meeting: at(13, 19), now: now, expectedOut: "next Tuesday at 7:00PM"},
+		{name: "Last week", meeting: at(2, 19), now: now, expectedOut: "last Friday at 7:00PM"},
+		{name: "In days", meeting: at(20, 19), now: now, expectedOut: "in 12 days (Tue) at 7:00PM"},
+		{name: "Days ago", meeting: time.Date(2016, time.August, 26, 19, 0, 0, 0, time.UTC), now: now, expectedOut: "13 days ago (Fri) at 7:00PM"},
+		{name: "Later", meeting: time.Date(2016, time.October, 10, 19, 0, 0, 0, time.UTC), now: now, expectedOut: "on Mon Oct 10 2016 at 7:00PM"},
+		{
+			name:        "Viewer's zone moves the day",
+			meeting:     at(9, 2),
+			now:         now.In(newYork),
+			expectedOut: "today at 10:00PM",
+		},
+		{
+			name:        "Viewer's zone across the week",
+			meeting:     at(12, 1),
+			now:         now.In(newYork),
+			expectedOut: "this Sunday at 9:00PM",
+		},
+		{
+			name:        "French",
+			options:     Options{Locale: French},
+			meeting:     at(13, 19),
+			now:         now,
+			expectedOut: "mardi prochain à 19:00",
+		},
+		{
+			name:        "German, one day",
+			options:     Options{Locale: German, Clock24: true},
+			meeting:     at(20, 19),
+			now:         at(19, 8),
+			expectedOut: "morgen um 19:00 Uhr",
+		},
+		{
+			name:        "Spanish in days",
+			options:     Options{Locale: Spanish},
+			meeting:     at(20, 19),
+			now:         now,
+			expectedOut: "dentro de 12 días (mar.) a las 19:00",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := test.options.Relative(test.meeting, test.now)
+			if out != test.expectedOut {
+				t.Errorf("expected %q, got %q", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestNextAndPreviousMeeting(t *testing.T) {
+	schedule := meetingtime.NewWeeklySchedule(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC), 1)
+	now := time.Date(2016, time.September, 8, 12, 0, 0, 0, time.UTC)
+
+	next, err := NextMeeting(schedule, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "next Monday at 7:00PM"; next != expected {
+		t.Errorf("expected %q, got %q", expected, next)
+	}
+
+	previous, err := PreviousMeeting(meetingtime.ScheduleSlice{schedule}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "3 days ago (Mon) at 7:00PM"; previous != expected {
+		t.Errorf("expected %q, got %q", expected, previous)
+	}
+
+	if _, err := PreviousMeeting(schedule, schedule.First); err != meetingtime.ErrNoEarlierMeetings {
+		t.Errorf("expected ErrNoEarlierMeetings, got %v", err)
+	}
+}
@@ -70,6 +70,46 @@ func TestScheduleDescription(t *testing.T) {
 			schedule:    meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 17, 0, 0, 0, 0, time.UTC)),
 			expectedOut: "Every 3rd Monday, starting Oct 17 2016 at 12:00AM",
 		},
+		{
+			name:        "Floating",
+			schedule:    meetingtime.Schedule{Type: meetingtime.Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true},
+			expectedOut: "Every day starting Fri Jan 01 2016 at 9:00AM",
+		},
+		{
+			name: "Later start",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Weekly,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Start:     time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectedOut: "Every week starting Mon Jan 06 2020 at 9:00AM",
+		},
+		{
+			name: "Later start, monthly by weekday",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.MonthlyByWeekday,
+				First:     time.Date(2016, time.October, 12, 19, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Start:     time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectedOut: "Every 2nd Wednesday, starting Mar 11 2020 at 7:00PM",
+		},
+		{
+			name: "Unbounded",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Weekly,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 2,
+				Unbounded: true,
+			},
+			expectedOut: "Every 2 weeks on Monday at 9:00AM",
+		},
+		{
+			name:        "Zero frequency",
+			schedule:    meetingtime.Schedule{Type: meetingtime.Weekly},
+			expectedErr: meetingtime.ErrZeroFrequency,
+		},
 		{
 			name:        "Invalid type",
 			schedule:    meetingtime.Schedule{Type: 100},
@@ -0,0 +1,127 @@
+package describe
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+/*
+ScheduleSlice generates an English description of an instance of meetingtime.ScheduleSlice.
+
+Schedules of the same type, frequency, time of day, start and daylight saving policies, that begin within one
+repetition of each other, are merged into a single phrase, such as "Every 1st and 3rd Monday at 7:00PM, starting
+Sep 05 2016" or "Every 2 weeks on Monday and Thursday at 9:00AM, starting Sep 05 2016". Schedules repeating every few
+weeks or months are only merged if they meet in the same weeks or months. Other Schedules are described individually,
+as a list. Unbounded Schedules have no first meeting, so their descriptions leave out when they start.
+*/
+func ScheduleSlice(schedules meetingtime.ScheduleSlice) (string, error) {
+	return Options{}.ScheduleSlice(schedules)
+}
+
+// ScheduleSlice generates a description of an instance of meetingtime.ScheduleSlice in the language of the Locale,
+// merging Schedules as the ScheduleSlice function does.
+func (l Locale) ScheduleSlice(schedules meetingtime.ScheduleSlice) (string, error) {
+	return Options{Locale: l}.ScheduleSlice(schedules)
+}
+
+// mergeSchedules groups Schedules that can be described in the same phrase, ordered by their first meetings
+func mergeSchedules(schedules meetingtime.ScheduleSlice) [][]meetingtime.Schedule {
+	sorted := append(meetingtime.ScheduleSlice(nil), schedules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return firstMeeting(sorted[i]).Before(firstMeeting(sorted[j]))
+	})
+
+	var groups [][]meetingtime.Schedule
+	for _, s := range sorted {
+		merged := false
+		for i, group := range groups {
+			if canMerge(group, s) {
+				groups[i] = append(group, s)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			groups = append(groups, []meetingtime.Schedule{s})
+		}
+	}
+	return groups
+}
+
+// canMerge returns true if a Schedule can be described in the same phrase as a group of Schedules,
+// which are sorted by their first meeting.
+func canMerge(group []meetingtime.Schedule, s meetingtime.Schedule) bool {
+	start := group[0]
+	if s.Type != start.Type || s.Floating != start.Floating ||
+		s.First.Location().String() != start.First.Location().String() || clockOf(s.First) != clockOf(start.First) ||
+		!s.Start.Equal(start.Start) || s.Unbounded != start.Unbounded || s.Gap != start.Gap || s.Overlap != start.Overlap {
+		return false
+	}
+	for _, member := range group {
+		if sameDay(member, s) {
+			return false
+		}
+	}
+	switch s.Type {
+	case meetingtime.Weekly:
+		// Meetings must fall in the same weeks
+		weeks := (weekStart(s.First) - weekStart(start.First)) / 7
+		if s.Frequency != start.Frequency || weeks%int(s.Frequency) != 0 {
+			return false
+		}
+	case meetingtime.Monthly:
+		// Meetings must fall in the same months
+		months := dates.MonthNumber(s.First) - dates.MonthNumber(start.First)
+		if s.Frequency != start.Frequency || months%int(s.Frequency) != 0 {
+			return false
+		}
+	case meetingtime.MonthlyByWeekday:
+		weekday, _ := meetingtime.GetWeekdayAndIndex(s.First)
+		if startWeekday, _ := meetingtime.GetWeekdayAndIndex(start.First); weekday != startWeekday {
+			return false
+		}
+	default:
+		return false
+	}
+	if s.Unbounded && s.Start.IsZero() {
+		return true
+	}
+	// The meeting before the first in the pattern of the Schedule must be before the start of the group, or the
+	// phrase would include it
+	pattern := s
+	pattern.Start, pattern.Unbounded = time.Time{}, true
+	previous, err := pattern.Previous(firstMeeting(s))
+	return err != nil || previous.Before(firstMeeting(start))
+}
+
+// sameDay returns true if two Schedules of the same type would be described by the same day
+func sameDay(a, b meetingtime.Schedule) bool {
+	switch a.Type {
+	case meetingtime.Weekly:
+		return a.First.Weekday() == b.First.Weekday()
+	case meetingtime.Monthly:
+		return a.First.Day() == b.First.Day()
+	case meetingtime.MonthlyByWeekday:
+		_, an := meetingtime.GetWeekdayAndIndex(a.First)
+		_, bn := meetingtime.GetWeekdayAndIndex(b.First)
+		return an == bn
+	}
+	return false
+}
+
+// weekStart returns the day number of the Monday starting the week of t
+func weekStart(t time.Time) int {
+	return dates.DayNumber(t) - isoWeekday(t)
+}
+
+// isoWeekday numbers the days of the week from Monday
+func isoWeekday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func clockOf(t time.Time) [4]int {
+	return [4]int{t.Hour(), t.Minute(), t.Second(), t.Nanosecond()}
+}
@@ -0,0 +1,216 @@
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestScheduleSliceDescription(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2016, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	var tests = []struct {
+		name        string
+		schedules   meetingtime.ScheduleSlice
+		expectedOut string
+		expectedErr error
+	}{
+		{
+			name:        "Single schedule",
+			schedules:   meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 12, 0))},
+			expectedOut: "Every 2nd Wednesday, starting Oct 12 2016 at 12:00AM",
+		},
+		{
+			name: "1st and 3rd Monday",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 19, 19)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 5, 19)),
+			},
+			expectedOut: "Every 1st and 3rd Monday at 7:00PM, starting Sep 05 2016",
+		},
+		{
+			name: "Days of the week",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 9, 9), 1),
+				meetingtime.NewWeeklySchedule(at(time.September, 7, 9), 1),
+				meetingtime.NewWeeklySchedule(at(time.September, 12, 9), 1),
+			},
+			expectedOut: "Every Monday, Wednesday and Friday at 9:00AM, starting Sep 07 2016",
+		},
+		{
+			name: "Days of the week every 2 weeks",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 5, 9), 2),
+				meetingtime.NewWeeklySchedule(at(time.September, 8, 9), 2),
+			},
+			expectedOut: "Every 2 weeks on Monday and Thursday at 9:00AM, starting Sep 05 2016",
+		},
+		{
+			name: "Days of the month",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(time.September, 1, 9), 1),
+				meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 1),
+			},
+			expectedOut: "Every month on the 1st and 15th at 9:00AM, starting Sep 01 2016",
+		},
+		{
+			name: "Days of the month every 3 months",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(time.September, 1, 9), 3),
+				meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 3),
+			},
+			expectedOut: "Every 3 months on the 1st and 15th at 9:00AM, starting Sep 01 2016",
+		},
+		{
+			name: "Different weeks every 2 weeks",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 12, 9), 2),
+				meetingtime.NewWeeklySchedule(at(time.September, 8, 9), 2),
+			},
+			expectedOut: "Every 2 weeks starting Thu Sep 08 2016 at 9:00AM; and every 2 weeks starting Mon Sep 12 2016 at 9:00AM",
+		},
+		{
+			name: "Same weeks every 2 weeks, starting in different weeks",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 1, 9), 2),
+				meetingtime.NewWeeklySchedule(at(time.September, 12, 9), 2),
+			},
+			expectedOut: "Every 2 weeks on Monday and Thursday at 9:00AM, starting Sep 01 2016",
+		},
+		{
+			name: "Same weeks every 2 weeks, starting a repetition later",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 1, 9), 2),
+				meetingtime.NewWeeklySchedule(at(time.September, 26, 9), 2),
+			},
+			expectedOut: "Every 2 weeks starting Thu Sep 01 2016 at 9:00AM; and every 2 weeks starting Mon Sep 26 2016 at 9:00AM",
+		},
+		{
+			name: "Same months every 3 months, starting in different months",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 3),
+				meetingtime.NewMonthlySchedule(at(time.December, 1, 9), 3),
+			},
+			expectedOut: "Every 3 months on the 1st and 15th at 9:00AM, starting Sep 15 2016",
+		},
+		{
+			name: "Different months every 3 months",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 3),
+				meetingtime.NewMonthlySchedule(at(time.October, 1, 9), 3),
+			},
+			expectedOut: "Every 3 months starting Thu Sep 15 2016 at 9:00AM; and every 3 months starting Sat Oct 01 2016 at 9:00AM",
+		},
+		{
+			name: "Later month",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 5, 19)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.October, 10, 19)),
+			},
+			expectedOut: "Every 1st Monday, starting Sep 05 2016 at 7:00PM; and every 2nd Monday, starting Oct 10 2016 at 7:00PM",
+		},
+		{
+			name: "Different times",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(time.September, 5, 9), 1),
+				meetingtime.NewWeeklySchedule(at(time.September, 5, 17), 1),
+				meetingtime.NewWeeklySchedule(at(time.September, 7, 17), 1),
+			},
+			expectedOut: "Every week starting Mon Sep 05 2016 at 9:00AM; and every Monday and Wednesday at 5:00PM, starting Sep 05 2016",
+		},
+		{
+			name: "Different types",
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewDailySchedule(at(time.January, 1, 9), 1),
+				meetingtime.NewYearlySchedule(at(time.January, 7, 0), 1),
+				meetingtime.NewMonthlyScheduleByWeekday(at(time.January, 4, 0)),
+			},
+			expectedOut: "Every day starting Fri Jan 01 2016 at 9:00AM; every 1st Monday, starting Jan 04 2016 at 12:00AM; and every year starting Thu Jan 07 2016 at 12:00AM",
+		},
+		{
+			name: "Unbounded",
+			schedules: meetingtime.ScheduleSlice{
+				{Type: meetingtime.Weekly, First: at(time.January, 4, 9), Frequency: 1, Unbounded: true},
+				{Type: meetingtime.Weekly, First: at(time.January, 6, 9), Frequency: 1, Unbounded: true},
+			},
+			expectedOut: "Every Monday and Wednesday at 9:00AM",
+		},
+		{
+			name: "Same start",
+			schedules: meetingtime.ScheduleSlice{
+				{Type: meetingtime.Weekly, First: at(time.January, 4, 9), Frequency: 1, Start: at(time.March, 1, 0)},
+				{Type: meetingtime.Weekly, First: at(time.January, 20, 9), Frequency: 1, Start: at(time.March, 1, 0)},
+			},
+			expectedOut: "Every Monday and Wednesday at 9:00AM, starting Mar 02 2016",
+		},
+		{
+			name: "Different starts",
+			schedules: meetingtime.ScheduleSlice{
+				{Type: meetingtime.Weekly, First: at(time.January, 4, 9), Frequency: 1, Start: at(time.March, 1, 0)},
+				{Type: meetingtime.Weekly, First: at(time.January, 6, 9), Frequency: 1},
+			},
+			expectedOut: "Every week starting Wed Jan 06 2016 at 9:00AM; and every week starting Mon Mar 07 2016 at 9:00AM",
+		},
+		{
+			name: "Unbounded and bounded",
+			schedules: meetingtime.ScheduleSlice{
+				{Type: meetingtime.Weekly, First: at(time.January, 4, 9), Frequency: 1, Unbounded: true},
+				{Type: meetingtime.Weekly, First: at(time.January, 6, 9), Frequency: 1},
+			},
+			expectedOut: "Every Monday at 9:00AM; and every week starting Wed Jan 06 2016 at 9:00AM",
+		},
+		{
+			name: "Different daylight saving policies",
+			schedules: meetingtime.ScheduleSlice{
+				{Type: meetingtime.Weekly, First: at(time.January, 4, 9), Frequency: 1, Gap: meetingtime.DSTSkip},
+				{Type: meetingtime.Weekly, First: at(time.January, 6, 9), Frequency: 1},
+			},
+			expectedOut: "Every week starting Mon Jan 04 2016 at 9:00AM; and every week starting Wed Jan 06 2016 at 9:00AM",
+		},
+		{
+			name:        "Empty",
+			expectedErr: meetingtime.ErrEmptySchedule,
+		},
+		{
+			name:        "Invalid schedule",
+			schedules:   meetingtime.ScheduleSlice{{Type: meetingtime.Weekly}},
+			expectedErr: meetingtime.ErrZeroFrequency,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := ScheduleSlice(test.schedules)
+			if err != test.expectedErr {
+				t.Errorf("Error not as expected. Expected '%v', got '%v'", test.expectedErr, err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestScheduleSliceLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10PM on Mondays and Wednesdays in New York is early on Tuesdays and Thursdays in UTC
+	at := func(day int) meetingtime.Schedule {
+		return meetingtime.Schedule{
+			Type:      meetingtime.Weekly,
+			First:     time.Date(2016, time.September, day, 2, 0, 0, 0, time.UTC),
+			Frequency: 1,
+			Location:  newYork,
+		}
+	}
+	out, err := Options{OmitStart: true}.ScheduleSlice(meetingtime.ScheduleSlice{at(6), at(8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Every Monday and Wednesday at 10:00PM"; out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+}
@@ -0,0 +1,86 @@
+package describe
+
+import "fmt"
+
+// Spanish describes schedules in Spanish
+var Spanish = Locale{
+	Tag: "es",
+	Messages: map[string]Message{
+		MessageDaily:                {One: "Todos los días a partir del {first}", Other: "Cada {n} días a partir del {first}"},
+		MessageWeekly:               {One: "Todas las semanas a partir del {first}", Other: "Cada {n} semanas a partir del {first}"},
+		MessageMonthly:              {One: "Todos los meses a partir del {first}", Other: "Cada {n} meses a partir del {first}"},
+		MessageYearly:               {One: "Todos los años a partir del {first}", Other: "Cada {n} años a partir del {first}"},
+		MessageMonthlyByWeekday:     {Other: "El {ordinal} {weekday} de cada mes, a partir del {first}"},
+		MessageWeeklyOn:             {One: "Cada {days} a las {time}", Other: "Cada {n} semanas, el {days} a las {time}"},
+		MessageMonthlyOn:            {One: "Todos los meses el {days} a las {time}", Other: "Cada {n} meses el {days} a las {time}"},
+		MessageMonthlyByWeekdays:    {Other: "El {ordinals} {weekday} de cada mes a las {time}"},
+		MessageDailyAt:              {One: "Todos los días a las {time}", Other: "Cada {n} días a las {time}"},
+		MessageYearlyOn:             {One: "Todos los años el {date} a las {time}", Other: "Cada {n} años el {date} a las {time}"},
+		MessageStarting:             {Other: "{description}, a partir del {start}"},
+		MessageZone:                 {Other: "{description} ({zone})"},
+		MessageUntil:                {Other: "{description} hasta el {end}"},
+		MessageExcept:               {One: "{description} (excepto el {dates})", Other: "{description} (excepto los días {dates})"},
+		MessageZoneTime:             {Other: "{time} {zone}"},
+		MessageZoneTimeNextDay:      {Other: "{time} {zone} (al día siguiente)"},
+		MessageZoneTimePreviousDay:  {Other: "{time} {zone} (el día anterior)"},
+		MessageZoneSeparator:        {Other: " / "},
+		MessageEveryDay:             {One: "a diario", Other: "cada {n} días"},
+		MessageEveryWeek:            {One: "cada semana", Other: "cada {n} semanas"},
+		MessageEveryMonth:           {One: "cada mes", Other: "cada {n} meses"},
+		MessageEveryYear:            {One: "cada año", Other: "cada {n} años"},
+		MessageDaysOfMonth:          {One: "el día {days}", Other: "los días {days}"},
+		MessageWeeksOfMonth:         {One: "el {ordinals} {weekday}", Other: "los {ordinals} {weekday}"},
+		MessageChanged:              {Other: "cambiado: antes {old}, ahora {new}"},
+		MessageFrequencyChanged:     {Other: "frecuencia cambiada: antes {old}, ahora {new}"},
+		MessageMoved:                {Other: "día cambiado: antes {old}, ahora {new}"},
+		MessageTimeChanged:          {Other: "hora cambiada: antes {old}, ahora {new}"},
+		MessageZoneChanged:          {Other: "zona horaria cambiada: antes {old}, ahora {new}"},
+		MessageStartChanged:         {Other: "primera reunión cambiada: antes el {old}, ahora el {new}"},
+		MessageRemovedMeetings:      {One: "sin reunión el {dates}", Other: "sin reuniones los días {dates}"},
+		MessageAddedMeetings:        {One: "nueva reunión el {dates}", Other: "nuevas reuniones los días {dates}"},
+		MessageToday:                {Other: "hoy a las {time}"},
+		MessageTomorrow:             {Other: "mañana a las {time}"},
+		MessageYesterday:            {Other: "ayer a las {time}"},
+		MessageThisWeek:             {Other: "este {weekday} a las {time}"},
+		MessageNextWeek:             {Other: "el próximo {weekday} a las {time}"},
+		MessageLastWeek:             {Other: "el {weekday} pasado a las {time}"},
+		MessageInDays:               {One: "dentro de {n} día ({day}) a las {time}", Other: "dentro de {n} días ({day}) a las {time}"},
+		MessageDaysAgo:              {One: "hace {n} día ({day}) a las {time}", Other: "hace {n} días ({day}) a las {time}"},
+		MessageOn:                   {Other: "el {first}"},
+		MessageAgendaDate:           {Other: "Fecha"},
+		MessageAgendaTime:           {Other: "Hora"},
+		MessageAgendaSchedule:       {Other: "Calendario"},
+		MessageAgendaStatus:         {Other: "Estado"},
+		MessageCancelled:            {Other: "Cancelada"},
+		MessageMovedTo:              {Other: "Trasladada al {first}"},
+		MessageAdded:                {Other: "Añadida"},
+		MessageListSeparator:        {Other: ", "},
+		MessageListAnd:              {Other: " y "},
+		MessageDescriptionSeparator: {Other: "; "},
+		MessageDescriptionAnd:       {Other: "; y "},
+	},
+	Plural: func(n int) PluralCategory {
+		if n == 1 {
+			return One
+		}
+		return Other
+	},
+	// Weekdays are masculine
+	Ordinal:  func(n int) string { return fmt.Sprintf("%d.º", n) },
+	MonthDay: func(n int) string { return fmt.Sprint(n) },
+
+	Weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	ShortWeekdays: [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+	Months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ShortMonths: [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.",
+		"jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+
+	OrdinalWords: [5]string{"primer", "segundo", "tercer", "cuarto", "quinto"},
+
+	WeekdayDateTimeLayout: "Monday 2 de January de 2006 a las 15:04",
+	DateTimeLayout:        "2 de January de 2006 a las 15:04",
+	DateLayout:            "2 de January de 2006",
+	MonthDayLayout:        "2 de January",
+	TimeLayout:            "15:04",
+}
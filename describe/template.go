@@ -0,0 +1,274 @@
+package describe
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+/*
+DefaultTemplate renders the descriptions generated by Schedule, ScheduleSlice and Options, in the language of the
+Locale of the Options, using the Messages of its catalog.
+*/
+const DefaultTemplate = `
+{{- define "time" -}}
+	{{- if and options.Zones .Zone -}}
+		{{- zoneTimes .Start -}}
+	{{- else -}}
+		{{- time .Start -}}
+	{{- end -}}
+{{- end -}}
+
+{{- define "first" -}}
+	{{- if eq .Cadence "daily" -}}
+		{{- message "daily" .Interval "{first}" (weekdayDateTime .Start) -}}
+	{{- else if eq .Cadence "weekly" -}}
+		{{- message "weekly" .Interval "{first}" (weekdayDateTime .Start) -}}
+	{{- else if eq .Cadence "monthly" -}}
+		{{- message "monthly" .Interval "{first}" (weekdayDateTime .Start) -}}
+	{{- else if eq .Cadence "monthly-by-weekday" -}}
+		{{- message "monthlyByWeekday" 1 "{ordinal}" (ordinal (index .Ordinals 0)) "{weekday}" (weekday (index .Weekdays 0)) "{first}" (dateTime .Start) -}}
+	{{- else if eq .Cadence "yearly" -}}
+		{{- message "yearly" .Interval "{first}" (weekdayDateTime .Start) -}}
+	{{- end -}}
+{{- end -}}
+
+{{- define "recurrence" -}}
+	{{- if eq .Cadence "daily" -}}
+		{{- message "dailyAt" .Interval "{time}" (include "time" .) -}}
+	{{- else if eq .Cadence "weekly" -}}
+		{{- message "weeklyOn" .Interval "{time}" (include "time" .) "{days}" (list (weekdays .Weekdays)) -}}
+	{{- else if eq .Cadence "monthly" -}}
+		{{- message "monthlyOn" .Interval "{time}" (include "time" .) "{days}" (list (monthDays .Days)) -}}
+	{{- else if eq .Cadence "monthly-by-weekday" -}}
+		{{- message "monthlyByWeekdays" (len .Ordinals) "{time}" (include "time" .) "{ordinals}" (list (ordinals .Ordinals)) "{weekday}" (weekday (index .Weekdays 0)) -}}
+	{{- else if eq .Cadence "yearly" -}}
+		{{- message "yearlyOn" .Interval "{time}" (include "time" .) "{date}" (monthAndDay .Start) -}}
+	{{- end -}}
+{{- end -}}
+
+{{- define "description" -}}
+	{{- if or options.OmitStart .Unbounded -}}
+		{{- template "recurrence" . -}}
+	{{- else if and (eq (len .Schedules) 1) (not (and options.Zones .Zone)) -}}
+		{{- template "first" . -}}
+	{{- else -}}
+		{{- message "starting" 0 "{description}" (include "recurrence" .) "{start}" (date .Start) -}}
+	{{- end -}}
+{{- end -}}
+
+{{- define "bounded" -}}
+	{{- $description := include "description" . -}}
+	{{- if not .End.IsZero -}}
+		{{- $description = message "until" 0 "{description}" $description "{end}" (date .End) -}}
+	{{- end -}}
+	{{- if .Exceptions -}}
+		{{- if sameYear .Exceptions -}}
+			{{- $description = message "except" (len .Exceptions) "{description}" $description "{dates}" (list (monthsAndDays .Exceptions)) -}}
+		{{- else -}}
+			{{- $description = message "except" (len .Exceptions) "{description}" $description "{dates}" (list (dates .Exceptions)) -}}
+		{{- end -}}
+	{{- end -}}
+	{{- $description -}}
+{{- end -}}
+
+{{- if and options.ShowZone .Zone -}}
+	{{- message "zone" 0 "{description}" (include "bounded" .) "{zone}" .Zone -}}
+{{- else -}}
+	{{- template "bounded" . -}}
+{{- end -}}
+`
+
+var defaultTemplate = template.Must(template.New("describe").Funcs(Options{}.funcs(nil)).Parse(DefaultTemplate))
+
+/*
+Template renders descriptions of schedules from their Parts, using text/template. The Options of a Template
+configure the functions available to it, which format parts of the description in the language of its Locale:
+
+	message id n [name value]...  the text of a Message of the Locale for the count n, with its placeholders replaced
+	ordinal n, ordinals ns        weeks of the month, as in "2nd"
+	weekday d, weekdays ds        days of the week, as in "Monday"
+	monthDay n, monthDays ns      days of the month, as in "15th"
+	month m                       a month, as in "January"
+	list items                    a list, as in "Monday, Wednesday and Friday"
+	weekdayDateTime t             a date with its day of the week and time, as in "Mon Jan 02 2006 at 3:04PM"
+	dateTime t                    a date and time, as in "Jan 02 2006 at 3:04PM"
+	date t                        a date, as in "Jan 02 2006"
+	monthAndDay t                 a day of the year, as in "Jan 02"
+	dates ts, monthsAndDays ts    lists of dates and days of the year
+	sameYear ts                   whether times are all in the same year
+	time t                        a time of day, as in "3:04PM"
+	zoneTimes t                   a time of day in each of the Zones of the Options, as in "7:00PM London / 2:00PM New York"
+	include name data             the output of a named template, as a string
+	options                       the Options of the Template
+
+For example, a template for weekly schedules might read:
+
+	Meets {{if eq .Interval 2}}fortnightly{{else}}weekly{{end}} on {{list (weekdays .Weekdays)}}
+*/
+type Template struct {
+	options  Options
+	template *template.Template
+}
+
+// NewTemplate parses a Template for generating descriptions with the given Options
+func NewTemplate(text string, options Options) (*Template, error) {
+	t := &Template{options: options}
+	parsed, err := template.New("describe").Funcs(options.funcs(nil)).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	t.template = parsed
+	return t, nil
+}
+
+// Execute renders the description of Parts to w
+func (t *Template) Execute(w io.Writer, parts Parts) error {
+	return execute(w, t.template, t.options, parts)
+}
+
+// Schedule generates a description of an instance of meetingtime.Schedule
+func (t *Template) Schedule(schedule meetingtime.Schedule) (string, error) {
+	return describeSchedule(t.template, t.options, schedule)
+}
+
+// ScheduleSlice generates a description of an instance of meetingtime.ScheduleSlice, rendering each group of
+// Schedules that repeat in the same way, as given by ScheduleSliceParts, and joining them in a list
+func (t *Template) ScheduleSlice(schedules meetingtime.ScheduleSlice) (string, error) {
+	return describeScheduleSlice(t.template, t.options, schedules)
+}
+
+// Series generates a description of the Schedules of an instance of meetingtime.Series, as ScheduleSlice does,
+// with the cancelled meetings of each group of Schedules as its Exceptions
+func (t *Template) Series(series meetingtime.Series) (string, error) {
+	return describeSeries(t.template, t.options, series)
+}
+
+func describeSchedule(tmpl *template.Template, o Options, schedule meetingtime.Schedule) (string, error) {
+	parts, err := ScheduleParts(schedule)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	err = execute(&b, tmpl, o, parts)
+	return b.String(), err
+}
+
+func describeScheduleSlice(tmpl *template.Template, o Options, schedules meetingtime.ScheduleSlice) (string, error) {
+	parts, err := ScheduleSliceParts(schedules)
+	if err != nil {
+		return "", err
+	}
+	return describeParts(tmpl, o, parts)
+}
+
+func describeSeries(tmpl *template.Template, o Options, series meetingtime.Series) (string, error) {
+	parts, err := SeriesParts(series)
+	if err != nil {
+		return "", err
+	}
+	return describeParts(tmpl, o, parts)
+}
+
+// describeParts renders each Parts and joins them in a list
+func describeParts(tmpl *template.Template, o Options, parts []Parts) (string, error) {
+	var descriptions []string
+	for _, p := range parts {
+		var b bytes.Buffer
+		if err := execute(&b, tmpl, o, p); err != nil {
+			return "", err
+		}
+		descriptions = append(descriptions, b.String())
+	}
+	return o.Locale.descriptions(descriptions), nil
+}
+
+/*
+execute renders a template with functions for the Options. The End of the Options is used for Parts without one, and
+the End and Exceptions are given in the time zone of the Start, with Exceptions after the End left out.
+*/
+func execute(w io.Writer, tmpl *template.Template, o Options, parts Parts) error {
+	clone, err := tmpl.Clone()
+	if err != nil {
+		return err
+	}
+	clone.Funcs(o.funcs(clone))
+	if parts.End.IsZero() {
+		parts.End = o.End
+	}
+	loc := parts.Start.Location()
+	if !parts.End.IsZero() {
+		parts.End = parts.End.In(loc)
+	}
+	var exceptions []time.Time
+	for _, e := range parts.Exceptions {
+		if parts.End.IsZero() || !e.After(parts.End) {
+			exceptions = append(exceptions, e.In(loc))
+		}
+	}
+	sort.Slice(exceptions, func(i, j int) bool { return exceptions[i].Before(exceptions[j]) })
+	parts.Exceptions = exceptions
+	return clone.Execute(w, parts)
+}
+
+// funcs returns the functions available to templates, with include executing templates associated with tmpl
+func (o Options) funcs(tmpl *template.Template) template.FuncMap {
+	l := o.Locale
+	return template.FuncMap{
+		"message": func(id string, n int, replacements ...string) string {
+			return l.message(id, n, replacements...)
+		},
+		"ordinal": o.ordinal,
+		"ordinals": func(ns []int) []string {
+			return mapStrings(len(ns), func(i int) string { return o.ordinal(ns[i]) })
+		},
+		"weekday": l.weekday,
+		"weekdays": func(ds []time.Weekday) []string {
+			return mapStrings(len(ds), func(i int) string { return l.weekday(ds[i]) })
+		},
+		"monthDay": l.monthDay,
+		"monthDays": func(ns []int) []string {
+			return mapStrings(len(ns), func(i int) string { return l.monthDay(ns[i]) })
+		},
+		"month":           func(m time.Month) string { return or(l.Months[m-1], m.String()) },
+		"list":            l.list,
+		"weekdayDateTime": o.formatWeekdayDateTime,
+		"dateTime":        o.formatDateTime,
+		"date":            o.formatDate,
+		"monthAndDay":     o.formatMonthDay,
+		"dates": func(ts []time.Time) []string {
+			return mapStrings(len(ts), func(i int) string { return o.formatDate(ts[i]) })
+		},
+		"monthsAndDays": func(ts []time.Time) []string {
+			return mapStrings(len(ts), func(i int) string { return o.formatMonthDay(ts[i]) })
+		},
+		"sameYear": func(ts []time.Time) bool {
+			for _, t := range ts {
+				if t.Year() != ts[0].Year() {
+					return false
+				}
+			}
+			return true
+		},
+		"time":      o.formatTime,
+		"zoneTimes": o.zoneTimes,
+		"include": func(name string, data interface{}) (string, error) {
+			var b bytes.Buffer
+			err := tmpl.ExecuteTemplate(&b, name, data)
+			return b.String(), err
+		},
+		"options": func() Options { return o },
+	}
+}
+
+func mapStrings(n int, f func(i int) string) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = f(i)
+	}
+	return out
+}
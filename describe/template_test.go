@@ -0,0 +1,156 @@
+package describe
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestTemplate(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.September, day, hour, 0, 0, 0, time.UTC)
+	}
+	const meets = `Meets {{if eq .Interval 2}}fortnightly{{else}}every {{.Interval}} weeks{{end}} on {{list (weekdays .Weekdays)}}s at {{time .Start}}`
+	var tests = []struct {
+		name        string
+		text        string
+		options     Options
+		schedules   meetingtime.ScheduleSlice
+		expectedOut string
+	}{
+		{
+			name:        "Fortnightly",
+			text:        meets,
+			schedules:   meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(at(6, 19), 2)},
+			expectedOut: "Meets fortnightly on Tuesdays at 7:00PM",
+		},
+		{
+			name: "Fortnightly on two days",
+			text: meets,
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(8, 19), 2),
+				meetingtime.NewWeeklySchedule(at(6, 19), 2),
+			},
+			expectedOut: "Meets fortnightly on Tuesday and Thursdays at 7:00PM",
+		},
+		{
+			name:        "Locale and options",
+			text:        `{{range .Ordinals}}{{ordinal .}} {{end}}{{weekday (index .Weekdays 0)}} {{month .Start.Month}} {{time .Start}}`,
+			options:     Options{Locale: German, SpellOrdinals: true},
+			schedules:   meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(at(13, 19))},
+			expectedOut: "zweiten Dienstag September 19:00 Uhr",
+		},
+		{
+			name: "Default template",
+			text: DefaultTemplate,
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(5, 19)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(19, 19)),
+			},
+			expectedOut: "Every 1st and 3rd Monday at 7:00PM, starting Sep 05 2016",
+		},
+		{
+			name: "List",
+			text: `{{.Cadence}}`,
+			schedules: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(5, 19)),
+				meetingtime.NewDailySchedule(at(6, 9), 1),
+			},
+			expectedOut: "monthly-by-weekday; and daily",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tmpl, err := NewTemplate(test.text, test.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := tmpl.ScheduleSlice(test.schedules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != test.expectedOut {
+				t.Errorf("Description expected '%v', got '%v'", test.expectedOut, out)
+			}
+		})
+	}
+}
+
+func TestTemplateExecute(t *testing.T) {
+	tmpl, err := NewTemplate(`{{message "weekly" .Interval "{first}" (date .Start)}}{{if not .End.IsZero}} until {{date .End}}{{end}}{{range .Exceptions}}, except {{monthAndDay .}}{{end}}`, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts, err := ScheduleParts(meetingtime.NewWeeklySchedule(time.Date(2016, time.December, 4, 10, 0, 0, 0, time.UTC), 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts.End = time.Date(2017, time.January, 29, 10, 0, 0, 0, time.UTC)
+	parts.Exceptions = []time.Time{time.Date(2016, time.December, 25, 10, 0, 0, 0, time.UTC)}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, parts); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Every week starting Dec 04 2016 until Jan 29 2017, except Dec 25"
+	if b.String() != expected {
+		t.Errorf("Description expected '%v', got '%v'", expected, b.String())
+	}
+}
+
+func TestTemplateErrors(t *testing.T) {
+	if _, err := NewTemplate(`{{unknown .}}`, Options{}); err == nil {
+		t.Error("expected an error for an unknown function")
+	}
+	tmpl, err := NewTemplate(`{{index .Ordinals 0}}`, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tmpl.Schedule(meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), 1)); err == nil {
+		t.Error("expected an error executing the template")
+	}
+	if _, err := tmpl.Schedule(meetingtime.Schedule{Type: meetingtime.Weekly}); err != meetingtime.ErrZeroFrequency {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrZeroFrequency, err)
+	}
+}
+
+func TestScheduleSliceParts(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour int) time.Time {
+		return time.Date(2016, time.September, day, hour, 0, 0, 0, london)
+	}
+	schedules := meetingtime.ScheduleSlice{
+		meetingtime.NewWeeklySchedule(at(9, 9), 1),
+		meetingtime.NewWeeklySchedule(at(11, 9), 1),
+		meetingtime.NewWeeklySchedule(at(5, 9), 1),
+		meetingtime.NewMonthlySchedule(at(15, 12), 2),
+		meetingtime.NewMonthlySchedule(at(1, 12), 2),
+		meetingtime.NewMonthlyScheduleByWeekday(at(19, 19)),
+		meetingtime.NewMonthlyScheduleByWeekday(at(5, 19)),
+		{Type: meetingtime.Yearly, First: at(1, 8), Frequency: 1, Floating: true},
+	}
+	parts, err := ScheduleSliceParts(schedules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Parts{
+		{Cadence: "yearly", Interval: 1, Start: at(1, 8)},
+		{Cadence: "monthly", Interval: 2, Days: []int{1, 15}, Start: at(1, 12), Zone: "Europe/London"},
+		{Cadence: "weekly", Interval: 1, Weekdays: []time.Weekday{time.Monday, time.Friday, time.Sunday}, Start: at(5, 9), Zone: "Europe/London"},
+		{Cadence: "monthly-by-weekday", Interval: 1, Weekdays: []time.Weekday{time.Monday}, Ordinals: []int{1, 3}, Start: at(5, 19), Zone: "Europe/London"},
+	}
+	if len(parts) != len(expected) {
+		t.Fatalf("expected %d parts, got %d", len(expected), len(parts))
+	}
+	for i := range parts {
+		parts[i].Schedules = nil
+		if !reflect.DeepEqual(parts[i], expected[i]) {
+			t.Errorf("parts %d: expected %+v, got %+v", i, expected[i], parts[i])
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package meetingtime
+
+import (
+	"fmt"
+	"time"
+)
+
+// DSTPolicy specifies how a Schedule handles meetings at local times that are skipped or repeated
+// by daylight saving transitions.
+type DSTPolicy uint8
+
+const (
+	// DSTShiftBack moves a meeting at a skipped time earlier by the length of the gap, and holds a
+	// meeting at a repeated time at the earlier of the two instants. This matches the behavior of time.Date.
+	DSTShiftBack DSTPolicy = iota
+	// DSTShiftForward moves a meeting at a skipped time later by the length of the gap, and holds a
+	// meeting at a repeated time at the later of the two instants.
+	DSTShiftForward
+	// DSTSkip cancels any meeting at a skipped or repeated time.
+	DSTSkip
+)
+
+var dstPolicyNames = map[DSTPolicy]string{
+	DSTShiftBack:    "shift-back",
+	DSTShiftForward: "shift-forward",
+	DSTSkip:         "skip",
+}
+
+func (p DSTPolicy) String() string {
+	if name, ok := dstPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("DSTPolicy(%d)", uint8(p))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the policy by name, such as "shift-forward".
+func (p DSTPolicy) MarshalText() ([]byte, error) {
+	if name, ok := dstPolicyNames[p]; ok {
+		return []byte(name), nil
+	}
+	return nil, ErrUnknownDSTPolicy
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a policy name as produced by MarshalText.
+func (p *DSTPolicy) UnmarshalText(text []byte) error {
+	for value, name := range dstPolicyNames {
+		if name == string(text) {
+			*p = value
+			return nil
+		}
+	}
+	return ErrUnknownDSTPolicy
+}
+
+// localTime returns the time in loc with the given date and clock time, applying the Schedule's
+// policies for clock times that are skipped or repeated by daylight saving transitions.
+// If the policy is to skip the meeting, skipped will be true and t will be the time given by time.Date.
+func (s Schedule) localTime(year int, month time.Month, day int, clock time.Time, loc *time.Location) (t time.Time, skipped bool) {
+	t = time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), loc)
+	wall := time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), time.UTC)
+
+	// Transitions are far enough apart that the offsets in effect a day either side of t
+	// will be those either side of any transition affecting t.
+	_, before := t.Add(-24 * time.Hour).Zone()
+	_, during := t.Zone()
+	_, after := t.Add(24 * time.Hour).Zone()
+	var instants []time.Time
+	for i, offset := range []int{before, during, after} {
+		if (i > 0 && offset == before) || (i > 1 && offset == during) {
+			continue
+		}
+		u := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, o := u.Zone(); o == offset {
+			instants = append(instants, u)
+		}
+	}
+
+	switch {
+	case len(instants) == 0:
+		// The clock time was skipped
+		switch s.Gap {
+		case DSTShiftForward:
+			return wall.Add(-time.Duration(before) * time.Second).In(loc), false
+		case DSTSkip:
+			return t, true
+		}
+		return wall.Add(-time.Duration(after) * time.Second).In(loc), false
+	case len(instants) > 1:
+		// The clock time was repeated
+		earlier, later := instants[0], instants[len(instants)-1]
+		if later.Before(earlier) {
+			earlier, later = later, earlier
+		}
+		switch s.Overlap {
+		case DSTShiftForward:
+			return later, false
+		case DSTSkip:
+			return earlier, true
+		}
+		return earlier, false
+	}
+	return instants[0], false
+}
@@ -0,0 +1,203 @@
+package meetingtime
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestDSTPolicy(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	london := mustLoadLocation(t, "Europe/London")
+	var tests = []struct {
+		name         string
+		schedule     Schedule
+		inTime       time.Time
+		expectedTime time.Time
+	}{
+		{
+			name:         "Gap, shift back",
+			schedule:     Schedule{Type: Daily, First: time.Date(2016, time.March, 10, 2, 30, 0, 0, newYork), Frequency: 1},
+			inTime:       time.Date(2016, time.March, 12, 12, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.March, 13, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "Gap, shift forward",
+			schedule:     Schedule{Type: Daily, First: time.Date(2016, time.March, 10, 2, 30, 0, 0, newYork), Frequency: 1, Gap: DSTShiftForward},
+			inTime:       time.Date(2016, time.March, 12, 12, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.March, 13, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "Gap, skip",
+			schedule:     Schedule{Type: Daily, First: time.Date(2016, time.March, 10, 2, 30, 0, 0, newYork), Frequency: 1, Gap: DSTSkip},
+			inTime:       time.Date(2016, time.March, 12, 12, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.March, 14, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:         "Gap, after the transition",
+			schedule:     Schedule{Type: Daily, First: time.Date(2016, time.March, 10, 2, 30, 0, 0, newYork), Frequency: 1, Gap: DSTShiftForward},
+			inTime:       time.Date(2016, time.March, 13, 12, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.March, 14, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "Overlap, shift back",
+			schedule:     Schedule{Type: Weekly, First: time.Date(2016, time.October, 23, 1, 30, 0, 0, newYork), Frequency: 1},
+			inTime:       time.Date(2016, time.November, 1, 0, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.November, 6, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "Overlap, shift forward",
+			schedule:     Schedule{Type: Weekly, First: time.Date(2016, time.October, 23, 1, 30, 0, 0, newYork), Frequency: 1, Overlap: DSTShiftForward},
+			inTime:       time.Date(2016, time.November, 1, 0, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.November, 6, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "Overlap, skip",
+			schedule:     Schedule{Type: Weekly, First: time.Date(2016, time.October, 23, 1, 30, 0, 0, newYork), Frequency: 1, Overlap: DSTSkip},
+			inTime:       time.Date(2016, time.November, 1, 0, 0, 0, 0, newYork),
+			expectedTime: time.Date(2016, time.November, 13, 1, 30, 0, 0, newYork),
+		},
+		{
+			name:         "London gap, monthly by weekday",
+			schedule:     Schedule{Type: MonthlyByWeekday, First: time.Date(2016, time.January, 24, 1, 15, 0, 0, london), Gap: DSTShiftForward},
+			inTime:       time.Date(2016, time.March, 1, 0, 0, 0, 0, london),
+			expectedTime: time.Date(2016, time.March, 27, 1, 15, 0, 0, time.UTC),
+		},
+		{
+			name:         "Explicit zone",
+			schedule:     Schedule{Type: Weekly, First: time.Date(2016, time.March, 7, 14, 0, 0, 0, time.UTC), Frequency: 1, Location: newYork},
+			inTime:       time.Date(2016, time.March, 15, 0, 0, 0, 0, time.UTC),
+			expectedTime: time.Date(2016, time.March, 21, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Implicit zone",
+			schedule:     Schedule{Type: Weekly, First: time.Date(2016, time.March, 7, 14, 0, 0, 0, time.UTC), Frequency: 1},
+			inTime:       time.Date(2016, time.March, 15, 0, 0, 0, 0, time.UTC),
+			expectedTime: time.Date(2016, time.March, 21, 14, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next, err := test.schedule.Next(test.inTime)
+			if err != nil {
+				t.Fatalf("Next error: %v", err)
+			}
+			if !next.Equal(test.expectedTime) {
+				t.Errorf("Next: expected '%v' got '%v'", test.expectedTime, next)
+			}
+			prev, err := test.schedule.Previous(next.Add(time.Nanosecond))
+			if err != nil {
+				t.Fatalf("Previous error: %v", err)
+			}
+			if !prev.Equal(next) {
+				t.Errorf("Previous: expected '%v' got '%v'", next, prev)
+			}
+		})
+	}
+}
+
+func TestDSTSkipIndexes(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	schedule := Schedule{Type: Daily, First: time.Date(2016, time.March, 10, 2, 30, 0, 0, newYork), Frequency: 1, Gap: DSTSkip}
+
+	if _, err := schedule.OccurrenceAt(3); err != ErrSkippedMeeting {
+		t.Errorf("expected '%v' got '%v'", ErrSkippedMeeting, err)
+	}
+	n, ok, err := schedule.IndexOf(time.Date(2016, time.March, 14, 2, 30, 0, 0, newYork))
+	if err != nil || !ok || n != 4 {
+		t.Errorf("expected 4 (true) got %v (%v), %v", n, ok, err)
+	}
+	if schedule.IsOccurrence(time.Date(2016, time.March, 13, 1, 30, 0, 0, newYork)) {
+		t.Errorf("expected skipped meeting not to be an occurrence")
+	}
+
+	// Years after 2037 use the zone's rule for future transitions, rather than those recorded
+	for _, years := range [][2]int{{2016, 2020}, {2039, 2042}} {
+		from := time.Date(years[0], time.January, 1, 0, 0, 0, 0, newYork)
+		count, err := schedule.Count(from, time.Date(years[1], time.January, 1, 0, 0, 0, 0, newYork))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected int
+		for c, err := schedule.Next(from); c.Year() < years[1]; c, err = schedule.Next(c) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected++
+		}
+		if count != expected {
+			t.Errorf("count %v: expected %v got %v", years, expected, count)
+		}
+	}
+}
+
+func TestFloating(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	schedule := Schedule{Type: Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true}
+
+	var tests = []struct {
+		name         string
+		inTime       time.Time
+		expectedNext time.Time
+		expectedPrev time.Time
+	}{
+		{
+			name:         "UTC",
+			inTime:       time.Date(2016, time.March, 1, 12, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2016, time.March, 2, 9, 0, 0, 0, time.UTC),
+			expectedPrev: time.Date(2016, time.March, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "New York",
+			inTime:       time.Date(2016, time.March, 1, 12, 0, 0, 0, newYork),
+			expectedNext: time.Date(2016, time.March, 2, 9, 0, 0, 0, newYork),
+			expectedPrev: time.Date(2016, time.March, 1, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:         "Tokyo",
+			inTime:       time.Date(2016, time.March, 1, 8, 0, 0, 0, tokyo),
+			expectedNext: time.Date(2016, time.March, 1, 9, 0, 0, 0, tokyo),
+			expectedPrev: time.Date(2016, time.February, 29, 9, 0, 0, 0, tokyo),
+		},
+		{
+			name:         "First meeting in Tokyo",
+			inTime:       time.Date(2015, time.December, 31, 0, 0, 0, 0, tokyo),
+			expectedNext: time.Date(2016, time.January, 1, 9, 0, 0, 0, tokyo),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next, err := schedule.Next(test.inTime)
+			if err != nil || !next.Equal(test.expectedNext) {
+				t.Errorf("Next: expected '%v' got '%v', %v", test.expectedNext, next, err)
+			}
+			if next.Location() != test.inTime.Location() {
+				t.Errorf("Next: expected location %v got %v", test.inTime.Location(), next.Location())
+			}
+			if !schedule.IsOccurrence(next) {
+				t.Errorf("expected '%v' to be an occurrence", next)
+			}
+			prev, err := schedule.Previous(test.inTime)
+			if test.expectedPrev.IsZero() {
+				if err != ErrNoEarlierMeetings {
+					t.Errorf("Previous: expected '%v' got '%v'", ErrNoEarlierMeetings, err)
+				}
+			} else if err != nil || !prev.Equal(test.expectedPrev) {
+				t.Errorf("Previous: expected '%v' got '%v', %v", test.expectedPrev, prev, err)
+			}
+		})
+	}
+
+	if err := (Schedule{Type: Daily, First: schedule.First, Frequency: 1, Floating: true, Location: tokyo}).Validate(); err != ErrFloatingLocation {
+		t.Errorf("expected '%v' got '%v'", ErrFloatingLocation, err)
+	}
+}
@@ -6,3 +6,43 @@ func (e errorStr) Error() string { return string(e) }
 
 // ErrNoEarlierMeetings indicates that Previous was called with a date before the first meeting of a Schedule
 const ErrNoEarlierMeetings = errorStr("no meetings on or before this date")
+
+// ErrZeroFrequency indicates that a Schedule has a Frequency of zero, so would never progress beyond its first meeting
+const ErrZeroFrequency = errorStr("schedule frequency must be greater than zero")
+
+// ErrUnknownScheduleType indicates that a Schedule has a Type other than those defined in this package
+const ErrUnknownScheduleType = errorStr("unknown schedule type")
+
+// ErrEmptySchedule indicates that a ScheduleSlice contains no Schedules, so has no meetings
+const ErrEmptySchedule = errorStr("no schedules")
+
+// ErrBudgetExhausted indicates that evaluation was stopped because it used up the budget set with WithEvaluationBudget
+const ErrBudgetExhausted = errorStr("evaluation budget exhausted")
+
+// ErrUnknownDSTPolicy indicates that a Schedule has a Gap or Overlap policy other than those defined in this package
+const ErrUnknownDSTPolicy = errorStr("unknown daylight saving policy")
+
+// ErrSkippedMeeting indicates that a meeting requested by index does not take place, due to the Schedule's DSTPolicy
+const ErrSkippedMeeting = errorStr("meeting skipped by daylight saving transition")
+
+// ErrOutOfRange indicates that a meeting was requested more than a million years from the first meeting,
+// beyond which its date cannot be calculated reliably
+const ErrOutOfRange = errorStr("meeting is out of range")
+
+// ErrFloatingLocation indicates that a Schedule is floating, but also has a Location, so its time zone is ambiguous
+const ErrFloatingLocation = errorStr("floating schedules cannot have a location")
+
+// ErrFloatingInstant indicates that an operation requiring fixed instants was attempted on a floating Schedule
+const ErrFloatingInstant = errorStr("floating schedules have no fixed instants")
+
+// ErrUnknownConversionMode indicates that Convert was called with a mode other than those defined in this package
+const ErrUnknownConversionMode = errorStr("unknown conversion mode")
+
+// ErrNoLaterMeetings indicates that Next was called with a date after the last meeting of a series that ends
+const ErrNoLaterMeetings = errorStr("no meetings after this date")
+
+// ErrMixedFloating indicates that a Series contains both floating and fixed Schedules, so its changes cannot be placed in time
+const ErrMixedFloating = errorStr("series cannot mix floating and fixed schedules")
+
+// ErrNotScheduled indicates that a Series cancels or moves a meeting that is not in its Schedules
+const ErrNotScheduled = errorStr("cancelled or moved meeting is not in the schedule")
@@ -0,0 +1,36 @@
+package meetingtime
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type budgetKey struct{}
+
+/*
+WithEvaluationBudget returns a copy of ctx that limits the number of steps that may be taken
+when evaluating schedules with it, such as when calling NextContext.
+
+Each meeting time calculated counts as one step, and the budget is shared by every evaluation
+using the returned context, including the members of a ScheduleSlice. Once the budget is used up,
+evaluation stops with ErrBudgetExhausted. This allows schedules from untrusted sources to be
+evaluated without the risk of tying up the CPU.
+*/
+func WithEvaluationBudget(ctx context.Context, steps int) context.Context {
+	remaining := int64(steps)
+	return context.WithValue(ctx, budgetKey{}, &remaining)
+}
+
+// step accounts for one step of evaluation, returning an error if ctx has been cancelled or
+// its evaluation budget has been used up.
+func step(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if remaining, ok := ctx.Value(budgetKey{}).(*int64); ok {
+		if atomic.AddInt64(remaining, -1) < 0 {
+			return ErrBudgetExhausted
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package meetingtime
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEvaluationBudget(t *testing.T) {
+	var schedules ScheduleSlice
+	for i := 0; i < 1000; i++ {
+		schedules = append(schedules, NewDailySchedule(time.Date(2016, time.January, 1, 0, i, 0, 0, time.UTC), 1))
+	}
+	in := time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		name        string
+		ctx         context.Context
+		expectedErr error
+	}{
+		{
+			name: "No budget",
+			ctx:  context.Background(),
+		},
+		{
+			name: "Sufficient budget",
+			ctx:  WithEvaluationBudget(context.Background(), 10000),
+		},
+		{
+			name:        "Insufficient budget",
+			ctx:         WithEvaluationBudget(context.Background(), 100),
+			expectedErr: ErrBudgetExhausted,
+		},
+		{
+			name:        "Cancelled",
+			ctx:         cancelled(),
+			expectedErr: context.Canceled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := schedules.NextContext(test.ctx, in); err != test.expectedErr {
+				t.Errorf("NextContext: expected '%v' got '%v'", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestEvaluationBudgetShared(t *testing.T) {
+	schedule := NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1)
+	ctx := WithEvaluationBudget(context.Background(), 20)
+	c := schedule.First
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		_, err = schedule.NextContext(ctx, c)
+		c = c.AddDate(0, 0, 7)
+	}
+	if err != ErrBudgetExhausted {
+		t.Errorf("expected '%v' got '%v'", ErrBudgetExhausted, err)
+	}
+	if _, err = schedule.PreviousContext(ctx, c); err != ErrBudgetExhausted {
+		t.Errorf("expected '%v' got '%v'", ErrBudgetExhausted, err)
+	}
+}
+
+func cancelled() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
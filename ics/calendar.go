@@ -0,0 +1,255 @@
+package ics
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/describe"
+	"github.com/theothertomelliott/meetingtime/rrule"
+)
+
+// DefaultProdID is the product identifier used for calendars that do not specify one.
+const DefaultProdID = "-//meetingtime//meetingtime//EN"
+
+// Calendar is an iCalendar document containing a set of events.
+type Calendar struct {
+	ProdID string    // Identifier for the product that created the calendar. If empty, DefaultProdID is used.
+	Stamp  time.Time // Time the calendar was created, used for DTSTAMP. If zero, the start of each event is used.
+	Events []Event
+}
+
+// Event is a meeting series to be included in a Calendar.
+type Event struct {
+	UID         string        // Unique identifier for the event. If empty, one is derived from the Series.
+	Summary     string        // Short title for the event. If empty, the description is used.
+	Description string        // Description of the event. If empty, the Schedules are described with describe.Schedule.
+	Duration    time.Duration // Length of each meeting
+	Series      meetingtime.Series
+}
+
+// vevent holds the properties of a VEVENT component.
+type vevent struct {
+	uid          string
+	stamp        time.Time
+	recurrenceID time.Time // Meeting replaced by this VEVENT, if it overrides one
+	start        time.Time
+	floating     bool
+	rule         string
+	exdates      []time.Time
+	rdates       []time.Time
+	duration     time.Duration
+	summary      string
+	description  string
+}
+
+/*
+WriteTo writes the Calendar as an iCalendar document, implementing io.WriterTo.
+
+Each Schedule in an Event's Series is written as a separate VEVENT with a recurrence rule, and a
+VTIMEZONE is included for each time zone used. Cancelled meetings are given by EXDATE, and added
+meetings by RDATE on the first VEVENT of the Series. Moved meetings are written as VEVENTs with a
+RECURRENCE-ID identifying the meeting they replace.
+
+The output depends only on the contents of the Calendar, so it is suitable for caching and comparison.
+If any event cannot be expressed in iCalendar, an error is returned and nothing is written.
+*/
+func (c Calendar) WriteTo(w io.Writer) (int64, error) {
+	var (
+		events []vevent
+		zones  = make(map[string]time.Time)
+	)
+	for _, e := range c.Events {
+		ve, err := c.vevents(e)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range ve {
+			if name := tzid(v.start.Location()); name != "" && !v.floating {
+				if first, ok := zones[name]; !ok || v.start.Before(first) {
+					zones[name] = v.start
+				}
+			}
+		}
+		events = append(events, ve...)
+	}
+
+	var out contentWriter
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	prodID := c.ProdID
+	if prodID == "" {
+		prodID = DefaultProdID
+	}
+	out.line("PRODID:%s", escapeText(prodID))
+	out.line("CALSCALE:GREGORIAN")
+
+	var names []string
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		timezone(&out, zones[name].Location(), zones[name])
+	}
+
+	for _, v := range events {
+		out.line("BEGIN:VEVENT")
+		out.line("UID:%s", escapeText(v.uid))
+		out.line("DTSTAMP:%sZ", v.stamp.UTC().Format(dateTimeLayout))
+		if !v.recurrenceID.IsZero() {
+			out.line("RECURRENCE-ID%s", dateTime(v.recurrenceID, v.floating))
+		}
+		out.line("DTSTART%s", dateTime(v.start, v.floating))
+		if v.duration > 0 {
+			out.line("DTEND%s", dateTime(v.start.Add(v.duration), v.floating))
+		}
+		if v.rule != "" {
+			out.line("RRULE:%s", v.rule)
+		}
+		if len(v.exdates) > 0 {
+			out.line("EXDATE%s", dateTimes(sortTimes(v.exdates, v.start.Location(), v.floating), v.floating))
+		}
+		if len(v.rdates) > 0 {
+			out.line("RDATE%s", dateTimes(sortTimes(v.rdates, v.start.Location(), v.floating), v.floating))
+		}
+		if v.summary != "" {
+			out.line("SUMMARY:%s", escapeText(v.summary))
+		}
+		if v.description != "" {
+			out.line("DESCRIPTION:%s", escapeText(v.description))
+		}
+		out.line("END:VEVENT")
+	}
+	out.line("END:VCALENDAR")
+	return out.WriteTo(w)
+}
+
+// vevents returns the VEVENT components for an Event.
+func (c Calendar) vevents(e Event) ([]vevent, error) {
+	series := e.Series
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+	uid := e.UID
+	if uid == "" {
+		data, err := json.Marshal(series)
+		if err != nil {
+			return nil, err
+		}
+		uid = fmt.Sprintf("%x@meetingtime", sha1.Sum(data))
+	}
+
+	if len(series.Schedules) == 0 {
+		// Only added meetings, so the first is the start of the event
+		added := sortTimes(series.Added, series.Added[0].Location(), false)
+		v := c.vevent(e, uid, added[0], false)
+		v.rdates = added[1:]
+		return []vevent{v}, nil
+	}
+
+	var events []vevent
+	cancelled, moved := series.Cancelled, series.Moved
+	for i, schedule := range series.Schedules {
+		r, err := rrule.FromSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %w", uid, err)
+		}
+		id := uid
+		if len(series.Schedules) > 1 {
+			// Number each event, keeping any domain at the end of the identifier
+			at := strings.LastIndex(uid, "@")
+			if at < 0 {
+				at = len(uid)
+			}
+			id = fmt.Sprintf("%s-%d%s", uid[:at], i+1, uid[at:])
+		}
+		v := c.vevent(e, id, r.Start, r.Floating)
+		v.rule = r.Rule.String()
+		if v.description == "" {
+			if v.description, err = describe.Schedule(schedule); err != nil {
+				return nil, err
+			}
+		}
+		if v.summary == "" {
+			v.summary = v.description
+		}
+
+		// Each cancelled meeting is excluded from the first Schedule that includes it
+		var remaining []time.Time
+		for _, t := range cancelled {
+			if schedule.IsOccurrence(t) {
+				v.exdates = append(v.exdates, t)
+			} else {
+				remaining = append(remaining, t)
+			}
+		}
+		cancelled = remaining
+		if i == 0 {
+			v.rdates = series.Added
+		}
+		events = append(events, v)
+
+		// Likewise, each moved meeting overrides a meeting of the first Schedule that includes it
+		var overrides []vevent
+		var unmoved []meetingtime.Move
+		for _, m := range moved {
+			if !schedule.IsOccurrence(m.From) {
+				unmoved = append(unmoved, m)
+				continue
+			}
+			o := c.vevent(e, id, m.To, r.Floating)
+			o.recurrenceID = m.From
+			if !r.Floating {
+				o.recurrenceID, o.start = m.From.In(r.Start.Location()), m.To.In(r.Start.Location())
+			}
+			o.summary, o.description = v.summary, v.description
+			overrides = append(overrides, o)
+		}
+		moved = unmoved
+		sort.Slice(overrides, func(i, j int) bool {
+			return overrides[i].recurrenceID.Before(overrides[j].recurrenceID)
+		})
+		events = append(events, overrides...)
+	}
+	return events, nil
+}
+
+// vevent returns a VEVENT with the properties of an Event that are common to all its Schedules.
+func (c Calendar) vevent(e Event, uid string, start time.Time, floating bool) vevent {
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = start
+	}
+	return vevent{
+		uid:         uid,
+		stamp:       stamp,
+		start:       start,
+		floating:    floating,
+		duration:    e.Duration,
+		summary:     e.Summary,
+		description: e.Description,
+	}
+}
+
+// sortTimes returns a sorted copy of times, in loc. If floating is true, the clock times are kept,
+// and given in UTC.
+func sortTimes(times []time.Time, loc *time.Location, floating bool) []time.Time {
+	out := make([]time.Time, len(times))
+	for i, t := range times {
+		if floating {
+			out[i] = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		} else {
+			out[i] = t.In(loc)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Before(out[j])
+	})
+	return out
+}
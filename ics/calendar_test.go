@@ -0,0 +1,170 @@
+package ics
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestCalendarWriteTo(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	newYork := mustLoadLocation(t, "America/New_York")
+	stamp := time.Date(2016, time.August, 1, 12, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name     string
+		calendar Calendar
+	}{
+		{
+			name: "weekly",
+			calendar: Calendar{
+				Stamp: stamp,
+				Events: []Event{{
+					UID:      "standup@example.com",
+					Summary:  "Standup; daily, except when it isn't",
+					Duration: 15 * time.Minute,
+					Series: meetingtime.Series{
+						Schedules: meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(time.Date(2016, time.December, 5, 9, 0, 0, 0, london), 1)},
+						Cancelled: []time.Time{
+							time.Date(2017, time.January, 2, 9, 0, 0, 0, london),
+							time.Date(2016, time.December, 26, 9, 0, 0, 0, london),
+						},
+						Added: []time.Time{time.Date(2016, time.December, 23, 9, 0, 0, 0, london)},
+						Moved: []meetingtime.Move{{
+							From: time.Date(2016, time.December, 12, 9, 0, 0, 0, london),
+							To:   time.Date(2016, time.December, 13, 14, 30, 0, 0, time.UTC),
+						}},
+					},
+				}},
+			},
+		},
+		{
+			name: "slice",
+			calendar: Calendar{
+				ProdID: "-//Example Corp//Meetups//EN",
+				Stamp:  stamp,
+				Events: []Event{
+					{
+						Summary:  "Go meetup",
+						Duration: 2 * time.Hour,
+						Series: meetingtime.Series{
+							Schedules: meetingtime.ScheduleSlice{
+								meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, newYork)),
+								meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, newYork)),
+							},
+							Cancelled: []time.Time{time.Date(2016, time.September, 19, 19, 0, 0, 0, newYork)},
+						},
+					},
+					{
+						UID:      "london@example.com",
+						Duration: time.Hour,
+						Series: meetingtime.Series{
+							Schedules: meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 12, 18, 30, 0, 0, london))},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "floating",
+			calendar: Calendar{
+				Events: []Event{{
+					UID:      "stretch@example.com",
+					Summary:  "Stretch",
+					Duration: 10 * time.Minute,
+					Series: meetingtime.Series{
+						Schedules: meetingtime.ScheduleSlice{{Type: meetingtime.Daily, First: time.Date(2016, time.January, 1, 11, 0, 0, 0, time.UTC), Frequency: 1, Floating: true}},
+						Cancelled: []time.Time{time.Date(2016, time.January, 3, 11, 0, 0, 0, time.UTC)},
+					},
+				}},
+			},
+		},
+		{
+			name: "added",
+			calendar: Calendar{
+				Stamp: stamp,
+				Events: []Event{{
+					UID:     "offsite@example.com",
+					Summary: "Offsite",
+					Series: meetingtime.Series{
+						Added: []time.Time{
+							time.Date(2016, time.November, 1, 9, 0, 0, 0, time.UTC),
+							time.Date(2016, time.October, 1, 9, 0, 0, 0, time.UTC),
+						},
+					},
+				}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if _, err := test.calendar.WriteTo(&out); err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", test.name+".ics")
+			if *update {
+				if err := os.WriteFile(golden, out.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out.Bytes(), expected) {
+				t.Errorf("output does not match %s:\n%s", golden, out.Bytes())
+			}
+
+			// Output should be stable
+			var again bytes.Buffer
+			if _, err := test.calendar.WriteTo(&again); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out.Bytes(), again.Bytes()) {
+				t.Errorf("output differs between calls")
+			}
+		})
+	}
+}
+
+func TestCalendarWriteToError(t *testing.T) {
+	calendar := Calendar{Events: []Event{{
+		Series: meetingtime.Series{
+			Schedules: meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1)},
+		},
+	}}}
+	var out bytes.Buffer
+	if _, err := calendar.WriteTo(&out); err == nil {
+		t.Errorf("expected an error")
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got %s", out.Bytes())
+	}
+}
+
+func TestLineFolding(t *testing.T) {
+	var w contentWriter
+	w.line("DESCRIPTION:%s", escapeText("Every 1st Monday, starting Sep 05 2016 at 7:00PM. Bring snacks, and a laptop; éèà"))
+	expected := "DESCRIPTION:Every 1st Monday\\, starting Sep 05 2016 at 7:00PM. Bring snacks\r\n" +
+		" \\, and a laptop\\; éèà\r\n"
+	if w.String() != expected {
+		t.Errorf("expected %q got %q", expected, w.String())
+	}
+}
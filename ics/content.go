@@ -0,0 +1,97 @@
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// maxLineLength is the maximum length of a content line in octets, excluding the line break.
+const maxLineLength = 75
+
+const dateTimeLayout = "20060102T150405"
+
+// contentWriter builds an iCalendar document from content lines.
+type contentWriter struct {
+	bytes.Buffer
+}
+
+// line writes a content line, folding it onto several lines if it is too long.
+func (w *contentWriter) line(format string, args ...interface{}) {
+	line := fmt.Sprintf(format, args...)
+	limit := maxLineLength
+	for len(line) > limit {
+		// Avoid splitting multi-octet characters
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		w.WriteString(line[:i])
+		w.WriteString("\r\n ")
+		line = line[i:]
+		// Continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// escapeText escapes a value of type TEXT.
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// dateTime returns the parameters and value of a DATE-TIME property, such as ";TZID=Europe/London:20160104T090000".
+// Times in zones without an IANA name are converted to UTC.
+func dateTime(t time.Time, floating bool) string {
+	return dateTimes([]time.Time{t}, floating)
+}
+
+// dateTimes returns the parameters and values of a DATE-TIME property with several values,
+// all of which are given in the time zone of the first.
+func dateTimes(times []time.Time, floating bool) string {
+	var params, suffix string
+	loc := times[0].Location()
+	switch name := tzid(loc); {
+	case floating:
+	case name != "":
+		params = ";TZID=" + name
+	default:
+		loc, suffix = time.UTC, "Z"
+	}
+	values := make([]string, len(times))
+	for i, t := range times {
+		if !floating {
+			t = t.In(loc)
+		}
+		values[i] = t.Format(dateTimeLayout) + suffix
+	}
+	return params + ":" + strings.Join(values, ",")
+}
+
+// tzid returns the TZID of times in loc, or an empty string if they are given in UTC, as they are when loc
+// is UTC or has no IANA name.
+func tzid(loc *time.Location) string {
+	if loc.String() == "UTC" {
+		return ""
+	}
+	return dates.ZoneName(loc)
+}
+
+// formatOffset formats a UTC offset in seconds as for TZOFFSETFROM and TZOFFSETTO, such as "-0500".
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+}
@@ -0,0 +1,5 @@
+/*
+Package ics generates and reads iCalendar (RFC 5545) documents for meeting series from the meetingtime package,
+so meetings can be published as calendar feeds, or imported from calendar exports.
+*/
+package ics
@@ -0,0 +1,21 @@
+package ics
+
+import "fmt"
+
+type errorStr string
+
+func (e errorStr) Error() string { return string(e) }
+
+// ErrInvalid indicates that an iCalendar document could not be parsed.
+// It is wrapped by errors describing the problem in more detail.
+const ErrInvalid = errorStr("invalid iCalendar document")
+
+// ErrNoRecurringEvent indicates that a VEVENT overrides a meeting of a recurring event that is not in the document
+const ErrNoRecurringEvent = errorStr("override has no recurring event")
+
+// ErrUnknownRecurrence indicates that a VEVENT overrides a meeting that is not part of its recurring event
+const ErrUnknownRecurrence = errorStr("RECURRENCE-ID is not a meeting of the event")
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalid}, args...)...)
+}
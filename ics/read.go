@@ -0,0 +1,416 @@
+package ics
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/contentline"
+	"github.com/theothertomelliott/meetingtime/rrule"
+)
+
+// SkippedEvent is a VEVENT that could not be translated by Read, with the reason why.
+type SkippedEvent struct {
+	UID          string
+	RecurrenceID time.Time // Meeting the VEVENT overrides, if it is an override of a single meeting
+	Summary      string
+	Err          error
+}
+
+/*
+Read reads an iCalendar document, such as a calendar export, returning a Calendar with an Event for each
+recurring VEVENT that can be expressed as a meetingtime.Series.
+
+Each RRULE becomes one or more Schedules, RDATE gives added meetings, and EXDATE cancelled meetings.
+VEVENTs with a RECURRENCE-ID become cancelled meetings if their STATUS is CANCELLED, or moved meetings if
+their DTSTART differs from the meeting they replace. Other changes to single meetings, such as a new
+summary, are not kept. Events with a single meeting, and cancelled events, are not included.
+
+Events that cannot be translated, such as all-day events, or events with rules that Schedules can't express,
+are returned as SkippedEvents. If a single override cannot be translated, it is skipped alone, and the rest of
+its event is kept. An error is returned only if the document cannot be parsed.
+*/
+func Read(r io.Reader) (Calendar, []SkippedEvent, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Calendar{}, nil, err
+	}
+	roots, err := parse(string(data))
+	if err != nil {
+		return Calendar{}, nil, err
+	}
+
+	var (
+		cal       Calendar
+		skipped   []SkippedEvent
+		uids      []string
+		masters   = make(map[string]*component)
+		overrides = make(map[string][]*component)
+	)
+	for _, root := range roots {
+		if root.name != "VCALENDAR" {
+			return Calendar{}, nil, invalid("unexpected %s component", root.name)
+		}
+		if cal.ProdID == "" {
+			cal.ProdID = root.text("PRODID")
+		}
+		for _, c := range root.components {
+			if c.name != "VEVENT" {
+				continue
+			}
+			uid := c.text("UID")
+			if masters[uid] == nil && overrides[uid] == nil {
+				uids = append(uids, uid)
+			}
+			if _, ok := c.property("RECURRENCE-ID"); ok {
+				overrides[uid] = append(overrides[uid], c)
+			} else {
+				masters[uid] = c
+			}
+		}
+	}
+
+	for _, uid := range uids {
+		master := masters[uid]
+		if master == nil {
+			for _, o := range overrides[uid] {
+				skipped = append(skipped, skip(o, ErrNoRecurringEvent))
+			}
+			continue
+		}
+		_, hasRule := master.property("RRULE")
+		_, hasDates := master.property("RDATE")
+		if !hasRule && !hasDates || strings.EqualFold(master.text("STATUS"), "CANCELLED") {
+			continue
+		}
+		event, s, err := readEvent(master, overrides[uid])
+		if err != nil {
+			skipped = append(skipped, skip(master, err))
+			continue
+		}
+		cal.Events = append(cal.Events, event)
+		skipped = append(skipped, s...)
+	}
+	return cal, skipped, nil
+}
+
+// readEvent translates a recurring VEVENT and the VEVENTs overriding its meetings into an Event.
+func readEvent(master *component, overrides []*component) (Event, []SkippedEvent, error) {
+	startProperty, ok := master.property("DTSTART")
+	if !ok {
+		return Event{}, nil, invalid("missing DTSTART")
+	}
+	starts, err := parseTimes(startProperty, time.UTC)
+	if err != nil {
+		return Event{}, nil, err
+	}
+	start := starts[0]
+	loc := start.Location()
+
+	var series meetingtime.Series
+	for _, rule := range master.all("RRULE") {
+		r, err := rrule.Parse(startProperty.line + "\n" + rule.line)
+		if err != nil {
+			return Event{}, nil, err
+		}
+		schedules, err := r.ScheduleSlice()
+		if err != nil {
+			return Event{}, nil, err
+		}
+		series.Schedules = append(series.Schedules, schedules...)
+	}
+	scheduled := func(t time.Time) bool {
+		return len(series.Schedules) > 0 && series.Schedules.IsOccurrence(t)
+	}
+
+	// DTSTART is always a meeting, even if the rules do not include it
+	if !scheduled(start) {
+		series.Added = append(series.Added, start)
+	}
+	for _, p := range master.all("RDATE") {
+		times, err := parseTimes(p, loc)
+		if err != nil {
+			return Event{}, nil, err
+		}
+		for _, t := range times {
+			if !scheduled(t) && indexOf(series.Added, t) < 0 {
+				series.Added = append(series.Added, t)
+			}
+		}
+	}
+	for _, p := range master.all("EXDATE") {
+		times, err := parseTimes(p, loc)
+		if err != nil {
+			return Event{}, nil, err
+		}
+		for _, t := range times {
+			series.Added, series.Cancelled = cancel(series, t)
+		}
+	}
+
+	var skipped []SkippedEvent
+	for _, o := range overrides {
+		p, _ := o.property("RECURRENCE-ID")
+		if strings.EqualFold(p.param("RANGE"), "THISANDFUTURE") {
+			return Event{}, nil, &rrule.UnsupportedError{Part: "RANGE=THISANDFUTURE", Reason: "changes to all later meetings are not supported"}
+		}
+		ids, err := parseTimes(p, loc)
+		if err != nil {
+			skipped = append(skipped, skip(o, err))
+			continue
+		}
+		id := ids[0]
+		if !scheduled(id) && indexOf(series.Added, id) < 0 {
+			skipped = append(skipped, skip(o, ErrUnknownRecurrence))
+			continue
+		}
+		if strings.EqualFold(o.text("STATUS"), "CANCELLED") {
+			series.Added, series.Cancelled = cancel(series, id)
+			continue
+		}
+		to := id
+		if p, ok := o.property("DTSTART"); ok {
+			times, err := parseTimes(p, loc)
+			if err != nil {
+				skipped = append(skipped, skip(o, err))
+				continue
+			}
+			to = times[0]
+		}
+		switch i := indexOf(series.Added, id); {
+		case to.Equal(id):
+		case i >= 0:
+			series.Added[i] = to
+		default:
+			series.Moved = append(series.Moved, meetingtime.Move{From: id, To: to})
+		}
+	}
+	if err := series.Validate(); err != nil {
+		return Event{}, nil, err
+	}
+
+	duration, err := readDuration(master, start)
+	if err != nil {
+		return Event{}, nil, err
+	}
+	return Event{
+		UID:         master.text("UID"),
+		Summary:     master.text("SUMMARY"),
+		Description: master.text("DESCRIPTION"),
+		Duration:    duration,
+		Series:      series,
+	}, skipped, nil
+}
+
+// cancel returns the added and cancelled meetings of a Series after cancelling the meeting at t.
+// Times that are not meetings of the Series are ignored.
+func cancel(series meetingtime.Series, t time.Time) (added, cancelled []time.Time) {
+	added, cancelled = series.Added, series.Cancelled
+	if i := indexOf(added, t); i >= 0 {
+		return append(added[:i:i], added[i+1:]...), cancelled
+	}
+	if len(series.Schedules) > 0 && series.Schedules.IsOccurrence(t) && indexOf(cancelled, t) < 0 {
+		cancelled = append(cancelled, t)
+	}
+	return added, cancelled
+}
+
+func indexOf(times []time.Time, t time.Time) int {
+	for i, u := range times {
+		if u.Equal(t) {
+			return i
+		}
+	}
+	return -1
+}
+
+// readDuration returns the length of each meeting of a VEVENT, from its DTEND or DURATION.
+func readDuration(event *component, start time.Time) (time.Duration, error) {
+	if p, ok := event.property("DTEND"); ok {
+		ends, err := parseTimes(p, start.Location())
+		if err != nil {
+			return 0, err
+		}
+		return ends[0].Sub(start), nil
+	}
+	if p, ok := event.property("DURATION"); ok {
+		return parseDuration(p.value)
+	}
+	return 0, nil
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration parses a value of type DURATION, such as "PT1H30M". Days are taken to be 24 hours long.
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(strings.ToUpper(value))
+	if m == nil || strings.Join(m[2:], "") == "" {
+		return 0, invalid("%q is not a duration", value)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, invalid("%q is not a duration", value)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+/*
+parseTimes parses the values of a DATE-TIME property. Values with a TZID are in that time zone, values in UTC
+are in UTC, and floating values are in loc. All-day values and periods are not supported.
+*/
+func parseTimes(p property, loc *time.Location) ([]time.Time, error) {
+	switch strings.ToUpper(p.param("VALUE")) {
+	case "DATE":
+		return nil, &rrule.UnsupportedError{Part: p.name + ";VALUE=DATE", Reason: "all-day events are not supported"}
+	case "PERIOD":
+		return nil, &rrule.UnsupportedError{Part: p.name + ";VALUE=PERIOD", Reason: "periods are not supported"}
+	}
+	if tzid := p.param("TZID"); tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return nil, &rrule.UnsupportedError{Part: "TZID=" + tzid, Reason: "only IANA time zones are supported"}
+		}
+	}
+	var times []time.Time
+	for _, value := range strings.Split(p.value, ",") {
+		if len(value) == len("20060102") {
+			return nil, &rrule.UnsupportedError{Part: p.name + ";VALUE=DATE", Reason: "all-day events are not supported"}
+		}
+		in := loc
+		if strings.HasSuffix(value, "Z") {
+			in = time.UTC
+		}
+		t, err := time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(value, "Z"), in)
+		if err != nil {
+			return nil, invalid("%s %q is not a date and time", p.name, value)
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+func skip(event *component, err error) SkippedEvent {
+	s := SkippedEvent{UID: event.text("UID"), Summary: event.text("SUMMARY"), Err: err}
+	if p, ok := event.property("RECURRENCE-ID"); ok {
+		if ids, err := parseTimes(p, time.UTC); err == nil {
+			s.RecurrenceID = ids[0]
+		}
+	}
+	return s
+}
+
+// component is a component of an iCalendar document, such as a VEVENT.
+type component struct {
+	name       string
+	properties []property
+	components []*component
+}
+
+// property is a property of a component, such as DTSTART.
+type property struct {
+	name   string
+	params []string
+	value  string
+	line   string // Unfolded content line
+}
+
+// property returns the first property of c with the given name.
+func (c *component) property(name string) (property, bool) {
+	for _, p := range c.properties {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+// all returns every property of c with the given name.
+func (c *component) all(name string) []property {
+	var out []property
+	for _, p := range c.properties {
+		if p.name == name {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// text returns the unescaped value of the first property of c with the given name, which has type TEXT.
+func (c *component) text(name string) string {
+	p, _ := c.property(name)
+	var b strings.Builder
+	for i := 0; i < len(p.value); i++ {
+		if p.value[i] == '\\' && i+1 < len(p.value) {
+			i++
+			if p.value[i] == 'n' || p.value[i] == 'N' {
+				b.WriteByte('\n')
+				continue
+			}
+		}
+		b.WriteByte(p.value[i])
+	}
+	return b.String()
+}
+
+// param returns the value of the parameter of p with the given name, without quotes.
+func (p property) param(name string) string {
+	for _, param := range p.params {
+		if n, value, _ := strings.Cut(param, "="); strings.EqualFold(n, name) {
+			return strings.Trim(value, `"`)
+		}
+	}
+	return ""
+}
+
+// parse parses an iCalendar document into its top level components.
+func parse(text string) ([]*component, error) {
+	var roots, stack []*component
+	for _, line := range contentline.Unfold(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		name, params, value, ok := contentline.Split(line)
+		if !ok {
+			return nil, invalid("no value in %q", line)
+		}
+		switch name {
+		case "BEGIN":
+			c := &component{name: strings.ToUpper(value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.components = append(parent.components, c)
+			} else {
+				roots = append(roots, c)
+			}
+			stack = append(stack, c)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != strings.ToUpper(value) {
+				return nil, invalid("unexpected END:%s", value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, invalid("%s property outside a component", name)
+			}
+			c := stack[len(stack)-1]
+			c.properties = append(c.properties, property{name: name, params: params, value: value, line: line})
+		}
+	}
+	if len(stack) > 0 {
+		return nil, invalid("missing END:%s", stack[len(stack)-1].name)
+	}
+	return roots, nil
+}
@@ -0,0 +1,266 @@
+package ics
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/rrule"
+)
+
+// Calendars written by WriteTo should be read back unchanged
+func TestReadRoundTrip(t *testing.T) {
+	stamp := time.Date(2016, time.August, 1, 12, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name  string
+		stamp time.Time
+	}{
+		{name: "weekly", stamp: stamp},
+		{name: "slice", stamp: stamp},
+		{name: "floating"},
+		{name: "added", stamp: stamp},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			golden, err := os.ReadFile(filepath.Join("testdata", test.name+".ics"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			cal, skipped, err := Read(bytes.NewReader(golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(skipped) > 0 {
+				t.Errorf("expected no skipped events, got %v", skipped)
+			}
+			cal.Stamp = test.stamp
+			var out bytes.Buffer
+			if _, err := cal.WriteTo(&out); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out.Bytes(), golden) {
+				t.Errorf("expected:\n%s\ngot:\n%s", golden, out.Bytes())
+			}
+		})
+	}
+}
+
+const export = `BEGIN:VCALENDAR
+PRODID:-//Google Inc//Google Calendar 70.9054//EN
+VERSION:2.0
+BEGIN:VEVENT
+DTSTART;TZID=America/New_York:20160905T190000
+DURATION:PT1H30M
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE
+EXDATE:20160912T230000Z
+UID:book-club@example.com
+SUMMARY:Book club\, with snacks
+DESCRIPTION:Bring the book.\nAnd a friend.
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;TZID=America/New_York:20160915T200000
+DTEND;TZID=America/New_York:20160915T213000
+RECURRENCE-ID;TZID=America/New_York:20160914T190000
+UID:book-club@example.com
+SUMMARY:Book club (moved)
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;TZID=America/New_York:20160919T190000
+RECURRENCE-ID;TZID=America/New_York:20160919T190000
+STATUS:CANCELLED
+UID:book-club@example.com
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;TZID=America/New_York:20160920T190000
+RECURRENCE-ID;TZID=America/New_York:20160920T190000
+UID:book-club@example.com
+SUMMARY:Not a meeting
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20160101
+RRULE:FREQ=YEARLY
+UID:new-year@example.com
+SUMMARY:New Year
+END:VEVENT
+BEGIN:VEVENT
+DTSTART:20160101T090000Z
+RRULE:FREQ=DAILY;COUNT=10
+UID:sprint@example.com
+SUMMARY:Sprint
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;TZID=Eastern Standard Time:20160101T090000
+RRULE:FREQ=DAILY
+UID:outlook@example.com
+SUMMARY:Outlook
+END:VEVENT
+BEGIN:VEVENT
+DTSTART:20160101T090000Z
+RECURRENCE-ID:20160101T090000Z
+UID:orphan@example.com
+SUMMARY:Orphan
+END:VEVENT
+BEGIN:VEVENT
+DTSTART:20160101T090000Z
+DTEND:20160101T100000Z
+UID:single@example.com
+SUMMARY:Just once
+END:VEVENT
+BEGIN:VEVENT
+DTSTART:20160101T090000
+RRULE:FREQ=MONTHLY;INTERVAL=3
+UID:quarterly@exam
+ ple.com
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestRead(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	cal, skipped, err := Read(strings.NewReader(export))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.ProdID != "-//Google Inc//Google Calendar 70.9054//EN" {
+		t.Errorf("unexpected product identifier %q", cal.ProdID)
+	}
+	if len(cal.Events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(cal.Events))
+	}
+
+	club := cal.Events[0]
+	if club.UID != "book-club@example.com" || club.Summary != "Book club, with snacks" || club.Description != "Bring the book.\nAnd a friend." {
+		t.Errorf("unexpected event %+v", club)
+	}
+	if club.Duration != 90*time.Minute {
+		t.Errorf("expected duration of 1h30m, got %v", club.Duration)
+	}
+	expected := []time.Time{
+		time.Date(2016, time.September, 5, 19, 0, 0, 0, newYork),
+		time.Date(2016, time.September, 7, 19, 0, 0, 0, newYork),
+		time.Date(2016, time.September, 15, 20, 0, 0, 0, newYork),
+		time.Date(2016, time.September, 21, 19, 0, 0, 0, newYork),
+		time.Date(2016, time.September, 26, 19, 0, 0, 0, newYork),
+	}
+	next := time.Date(2016, time.September, 1, 0, 0, 0, 0, newYork)
+	for i, e := range expected {
+		if next, err = club.Series.Next(next); err != nil {
+			t.Fatal(err)
+		}
+		if !next.Equal(e) {
+			t.Errorf("meeting %d: expected %v got %v", i, e, next)
+		}
+	}
+
+	quarterly := cal.Events[1]
+	if quarterly.UID != "quarterly@example.com" || len(quarterly.Series.Schedules) != 1 || !quarterly.Series.Schedules[0].Floating {
+		t.Errorf("expected a floating event, got %+v", quarterly)
+	}
+
+	var unsupported *rrule.UnsupportedError
+	var expectedSkipped = []struct {
+		uid         string
+		unsupported string
+		err         error
+	}{
+		{uid: "book-club@example.com", err: ErrUnknownRecurrence},
+		{uid: "new-year@example.com", unsupported: "DTSTART;VALUE=DATE"},
+		{uid: "sprint@example.com", unsupported: "COUNT"},
+		{uid: "outlook@example.com", unsupported: "TZID=Eastern Standard Time"},
+		{uid: "orphan@example.com", err: ErrNoRecurringEvent},
+	}
+	if len(skipped) != len(expectedSkipped) {
+		t.Fatalf("expected %d skipped events, got %v", len(expectedSkipped), skipped)
+	}
+	for i, e := range expectedSkipped {
+		s := skipped[i]
+		if s.UID != e.uid {
+			t.Errorf("skipped %d: expected %q got %q", i, e.uid, s.UID)
+		}
+		switch {
+		case e.err != nil && s.Err != e.err:
+			t.Errorf("skipped %d: expected '%v' got '%v'", i, e.err, s.Err)
+		case e.unsupported != "" && (!errors.As(s.Err, &unsupported) || unsupported.Part != e.unsupported):
+			t.Errorf("skipped %d: expected %s to be unsupported, got '%v'", i, e.unsupported, s.Err)
+		}
+	}
+	if !skipped[0].RecurrenceID.Equal(time.Date(2016, time.September, 20, 19, 0, 0, 0, newYork)) {
+		t.Errorf("unexpected recurrence id %v", skipped[0].RecurrenceID)
+	}
+}
+
+func TestReadAddedOverrides(t *testing.T) {
+	cal, skipped, err := Read(strings.NewReader(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:offsite@example.com
+DTSTART:20161001T090000Z
+RDATE:20161101T090000Z,20161201T090000Z
+EXDATE:20161201T090000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:offsite@example.com
+RECURRENCE-ID:20161101T090000Z
+DTSTART:20161102T090000Z
+END:VEVENT
+END:VCALENDAR
+`))
+	if err != nil || len(skipped) > 0 {
+		t.Fatal(err, skipped)
+	}
+	expected := meetingtime.Series{Added: []time.Time{
+		time.Date(2016, time.October, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2016, time.November, 2, 9, 0, 0, 0, time.UTC),
+	}}
+	added := cal.Events[0].Series.Added
+	if len(added) != len(expected.Added) || !added[0].Equal(expected.Added[0]) || !added[1].Equal(expected.Added[1]) {
+		t.Errorf("expected %v got %v", expected.Added, added)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	var tests = []struct {
+		name string
+		in   string
+	}{
+		{name: "Missing END", in: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR\n"},
+		{name: "Property outside component", in: "UID:x\n"},
+		{name: "No value", in: "BEGIN:VCALENDAR\nUID\nEND:VCALENDAR\n"},
+		{name: "Not a calendar", in: "BEGIN:VEVENT\nEND:VEVENT\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := Read(strings.NewReader(test.in)); !errors.Is(err, ErrInvalid) {
+				t.Errorf("expected '%v' got '%v'", ErrInvalid, err)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	var tests = []struct {
+		in       string
+		expected time.Duration
+		valid    bool
+	}{
+		{in: "PT1H30M", expected: 90 * time.Minute, valid: true},
+		{in: "P1W", expected: 7 * 24 * time.Hour, valid: true},
+		{in: "P1DT12H", expected: 36 * time.Hour, valid: true},
+		{in: "-PT15M", expected: -15 * time.Minute, valid: true},
+		{in: "P"},
+		{in: "PT"},
+		{in: "1H"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			d, err := parseDuration(test.in)
+			if (err == nil) != test.valid || d != test.expected {
+				t.Errorf("expected %v (valid: %v) got %v, %v", test.expected, test.valid, d, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package ics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// transition is a change in UTC offset for a time zone.
+type transition struct {
+	at       time.Time // Instant of the transition
+	onset    time.Time // Local time of the transition using the offset before it, in UTC
+	from, to int       // UTC offsets before and after the transition
+	name     string    // Abbreviation for the zone after the transition
+	dst      bool      // Whether daylight saving time is in effect after the transition
+}
+
+// observance is a series of transitions described by a STANDARD or DAYLIGHT component of a VTIMEZONE.
+type observance struct {
+	transitions []transition
+	rule        string // RRULE describing the transitions, if they follow a yearly pattern
+	open        bool   // If true, the transitions continue after the last one
+}
+
+// timezone writes a VTIMEZONE for loc, with observances covering all times from the given time onwards.
+func timezone(w *contentWriter, loc *time.Location, from time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:%s", loc.String())
+	for _, o := range observances(loc, from) {
+		first := o.transitions[0]
+		component := "STANDARD"
+		if first.dst {
+			component = "DAYLIGHT"
+		}
+		w.line("BEGIN:%s", component)
+		w.line("DTSTART:%s", first.onset.Format(dateTimeLayout))
+		w.line("TZOFFSETFROM:%s", formatOffset(first.from))
+		w.line("TZOFFSETTO:%s", formatOffset(first.to))
+		if first.name != "" {
+			w.line("TZNAME:%s", escapeText(first.name))
+		}
+		switch {
+		case o.rule != "" && o.open:
+			w.line("RRULE:%s", o.rule)
+		case o.rule != "":
+			w.line("RRULE:%s;UNTIL=%sZ", o.rule, o.transitions[len(o.transitions)-1].at.UTC().Format(dateTimeLayout))
+		case len(o.transitions) > 1:
+			var onsets []time.Time
+			for _, t := range o.transitions[1:] {
+				onsets = append(onsets, t.onset)
+			}
+			w.line("RDATE%s", dateTimes(onsets, true))
+		}
+		w.line("END:%s", component)
+	}
+	w.line("END:VTIMEZONE")
+}
+
+// transitions returns the transitions of loc from the one in effect at the given time, until the end of the
+// given year. If no transition has taken place before the given time, one is made up at the Unix epoch.
+func transitions(loc *time.Location, from time.Time, until int) []transition {
+	from = from.In(loc)
+	start, _ := from.ZoneBounds()
+	madeUp := start.IsZero()
+	if madeUp {
+		start = time.Unix(0, 0).In(loc)
+	}
+	var out []transition
+	for t := start; !t.IsZero() && t.Year() <= until; t = dates.NextTransition(t) {
+		name, to := t.Zone()
+		_, before := t.Add(-time.Second).Zone()
+		if madeUp && len(out) == 0 {
+			before = to
+		}
+		if before == to && len(out) > 0 && out[len(out)-1].name == name && out[len(out)-1].dst == t.IsDST() {
+			// Bounds beyond the recorded transitions, where nothing changes
+			continue
+		}
+		out = append(out, transition{
+			at:    t,
+			onset: t.UTC().Add(time.Duration(before) * time.Second),
+			from:  before,
+			to:    to,
+			name:  name,
+			dst:   t.IsDST(),
+		})
+	}
+	return out
+}
+
+// observances groups the transitions of loc from the given time onwards into observances, describing
+// transitions that take place at the same time each year with a recurrence rule.
+func observances(loc *time.Location, from time.Time) []observance {
+	// Go time zones describe transitions after 2037 with a rule, so looking a few years beyond
+	// that will find any yearly pattern that continues indefinitely.
+	until := from.Year()
+	if until < 2037 {
+		until = 2037
+	}
+	until += 3
+
+	type kind struct {
+		month    time.Month
+		clock    time.Duration
+		from, to int
+		name     string
+		dst      bool
+	}
+	var groups []observance
+	current := make(map[kind]int)
+	for _, t := range transitions(loc, from, until) {
+		k := kind{
+			month: t.onset.Month(),
+			clock: t.onset.Sub(dates.Date(t.onset)),
+			from:  t.from,
+			to:    t.to,
+			name:  t.name,
+			dst:   t.dst,
+		}
+		if i, ok := current[k]; ok {
+			last := groups[i].transitions[len(groups[i].transitions)-1]
+			if last.onset.Year() == t.onset.Year()-1 {
+				groups[i].transitions = append(groups[i].transitions, t)
+				continue
+			}
+		}
+		current[k] = len(groups)
+		groups = append(groups, observance{transitions: []transition{t}})
+	}
+
+	for i := range groups {
+		g := &groups[i]
+		if len(g.transitions) < 2 {
+			continue
+		}
+		g.rule = yearlyRule(g.transitions)
+		g.open = g.transitions[len(g.transitions)-1].onset.Year() == until
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].transitions[0].at.Before(groups[j].transitions[0].at)
+	})
+	return groups
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// yearlyRule returns a recurrence rule for transitions in consecutive years, in the same month and at the same
+// local time, or an empty string if they do not follow a pattern.
+func yearlyRule(transitions []transition) string {
+	var (
+		first             = transitions[0].onset
+		sameDay, sameWeek = true, true
+		last              = true
+		minDay, maxDay    = first.Day(), first.Day()
+	)
+	for _, t := range transitions {
+		d := t.onset
+		sameDay = sameDay && d.Day() == first.Day()
+		sameWeek = sameWeek && d.Weekday() == first.Weekday() && (d.Day()-1)/7 == (first.Day()-1)/7
+		last = last && d.Weekday() == first.Weekday() && d.Day()+7 > dates.DaysIn(d.Year(), d.Month())
+		if d.Day() < minDay {
+			minDay = d.Day()
+		}
+		if d.Day() > maxDay {
+			maxDay = d.Day()
+		}
+	}
+	rule := fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d", first.Month())
+	day := weekdayNames[first.Weekday()]
+	switch {
+	case sameDay:
+		return rule + fmt.Sprintf(";BYMONTHDAY=%d", first.Day())
+	case last:
+		return rule + ";BYDAY=-1" + day
+	case sameWeek && (first.Day()-1)/7 < 4:
+		return rule + fmt.Sprintf(";BYDAY=%d%s", (first.Day()-1)/7+1, day)
+	case maxDay-minDay < 7:
+		// The first of a day of the week on or after a given date
+		days := make([]string, 7)
+		for i := range days {
+			days[i] = fmt.Sprint(minDay + i)
+		}
+		for _, t := range transitions {
+			if t.onset.Weekday() != first.Weekday() {
+				return ""
+			}
+		}
+		return rule + ";BYDAY=" + day + ";BYMONTHDAY=" + strings.Join(days, ",")
+	}
+	return ""
+}
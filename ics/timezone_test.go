@@ -0,0 +1,82 @@
+package ics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/rrule"
+)
+
+// Observances should describe exactly the transitions known to Go
+func TestObservances(t *testing.T) {
+	from := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2041, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{
+		"Europe/London",
+		"America/New_York",
+		"America/Sao_Paulo",
+		"Australia/Sydney",
+		"Asia/Tokyo",
+		"Asia/Jerusalem",
+		"Pacific/Auckland",
+		"Africa/Casablanca",
+	} {
+		t.Run(name, func(t *testing.T) {
+			loc := mustLoadLocation(t, name)
+			expected := make(map[int64]bool)
+			for _, tr := range transitions(loc, from, 2040) {
+				expected[tr.at.Unix()] = true
+			}
+
+			got := make(map[int64]bool)
+			for _, o := range observances(loc, from) {
+				first := o.transitions[0]
+				// Evaluate onsets in a zone with the offset before the transitions, to find their instants
+				before := time.FixedZone("", first.from)
+				onsets := []time.Time{first.onset}
+				if o.rule != "" {
+					// UNTIL is an instant, which a floating recurrence can't compare against, so it is applied here
+					until := end
+					if !o.open {
+						until = o.transitions[len(o.transitions)-1].at
+					}
+					r, err := rrule.Parse("DTSTART:" + first.onset.Format(dateTimeLayout) + "\nRRULE:" + o.rule)
+					if err != nil {
+						t.Fatal(err)
+					}
+					onsets = nil
+					next := time.Date(first.onset.Year(), first.onset.Month(), first.onset.Day(), 0, 0, 0, 0, before).Add(-time.Second)
+					for {
+						if next, err = r.Next(next); err == meetingtime.ErrNoLaterMeetings || next.After(until) {
+							break
+						}
+						if err != nil {
+							t.Fatal(err)
+						}
+						onsets = append(onsets, next)
+					}
+				} else {
+					for _, tr := range o.transitions[1:] {
+						onsets = append(onsets, tr.onset)
+					}
+				}
+				for _, onset := range onsets {
+					at := time.Date(onset.Year(), onset.Month(), onset.Day(), onset.Hour(), onset.Minute(), onset.Second(), 0, before)
+					got[at.Unix()] = true
+				}
+			}
+
+			for at := range expected {
+				if !got[at] {
+					t.Errorf("missing transition at %v", time.Unix(at, 0).In(loc))
+				}
+			}
+			for at := range got {
+				if !expected[at] {
+					t.Errorf("unexpected transition at %v", time.Unix(at, 0).In(loc))
+				}
+			}
+		})
+	}
+}
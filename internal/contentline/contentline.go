@@ -0,0 +1,45 @@
+/*
+Package contentline reads the content lines of iCalendar documents, as defined by RFC 5545, for the rrule and ics
+packages.
+*/
+package contentline
+
+import "strings"
+
+// Unfold splits text into content lines, joining lines that begin with whitespace onto the line before.
+func Unfold(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Split splits a content line into the upper case property name, parameters and value. If the line has no value,
+// ok will be false.
+func Split(line string) (name string, params []string, value string, ok bool) {
+	var (
+		parts  []string
+		quoted bool
+		from   int
+	)
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			parts = append(parts, line[from:i])
+			from = i + 1
+		case c == ':':
+			parts = append(parts, line[from:i])
+			return strings.ToUpper(parts[0]), parts[1:], line[i+1:], true
+		}
+	}
+	return "", nil, "", false
+}
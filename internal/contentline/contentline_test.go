@@ -0,0 +1,35 @@
+package contentline
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnfold(t *testing.T) {
+	lines := Unfold("DTSTART:20160104T090000Z\r\nRRULE:FREQ=WEEKLY;\r\n INTERVAL=2\r\n\tCOUNT=3\r\n")
+	expected := []string{"DTSTART:20160104T090000Z", "RRULE:FREQ=WEEKLY;INTERVAL=2COUNT=3", ""}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("expected %q, got %q", expected, lines)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	var tests = []struct {
+		line   string
+		name   string
+		params []string
+		value  string
+		ok     bool
+	}{
+		{line: "rrule:FREQ=DAILY", name: "RRULE", params: []string{}, value: "FREQ=DAILY", ok: true},
+		{line: "DTSTART;TZID=Europe/London:20160104T090000", name: "DTSTART", params: []string{"TZID=Europe/London"}, value: "20160104T090000", ok: true},
+		{line: `X-NAME;LABEL="a;b:c":value`, name: "X-NAME", params: []string{`LABEL="a;b:c"`}, value: "value", ok: true},
+		{line: "NO-VALUE"},
+	}
+	for _, test := range tests {
+		name, params, value, ok := Split(test.line)
+		if name != test.name || !reflect.DeepEqual(params, test.params) || value != test.value || ok != test.ok {
+			t.Errorf("%s: expected %q %q %q %v, got %q %q %q %v", test.line, test.name, test.params, test.value, test.ok, name, params, value, ok)
+		}
+	}
+}
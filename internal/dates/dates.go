@@ -0,0 +1,102 @@
+/*
+Package dates provides calendar and time zone helpers shared by the meetingtime packages.
+*/
+package dates
+
+import "time"
+
+// DaysIn returns the number of days in a month. Months beyond December are normalized into the following years,
+// as with time.Date.
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Date returns the date of t, ignoring time zones, as midnight UTC.
+func Date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DayNumber returns the number of days between the Unix epoch and the date of t, ignoring time zones.
+func DayNumber(t time.Time) int {
+	return int(floorDiv64(Date(t).Unix(), 24*60*60))
+}
+
+// MonthNumber returns the number of months between year zero and the month of t.
+func MonthNumber(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// FloorDiv divides a by b, rounding towards negative infinity rather than towards zero.
+func FloorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorDiv64(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// Mod returns the remainder of FloorDiv, which has the sign of b.
+func Mod(a, b int) int {
+	return a - FloorDiv(a, b)*b
+}
+
+// NextTransition returns the time of the first change in UTC offset after t, or the zero time if there are none.
+// Beyond the transitions recorded for a zone, time.Time.ZoneBounds can report the end of the zone as a time
+// that is not after t, near the end of leap years, so such bounds are stepped over.
+func NextTransition(t time.Time) time.Time {
+	_, end := t.ZoneBounds()
+	for !end.IsZero() && !end.After(t) {
+		t = t.Add(24 * time.Hour)
+		_, end = t.ZoneBounds()
+	}
+	return end
+}
+
+// TransitionYears is the number of years after a schedule starts over which meetings near daylight saving transitions
+// are compared. The days of the week, and so the dates of most transitions, repeat every 28 years, and time zone
+// rules are rarely known further ahead than this.
+const TransitionYears = 28
+
+// SameAtTransitions returns true if next and reference give the same meeting after a time shortly before each
+// daylight saving transition of the zone of start, within TransitionYears of start. It is used to check that the
+// daylight saving policy of a schedule, used by next, treats meetings in the same way as a reference policy.
+func SameAtTransitions(start time.Time, next, reference func(time.Time) (time.Time, error)) (bool, error) {
+	// Only meetings within a few hours of a transition can be affected by it
+	const window = 3 * time.Hour
+	end := start.AddDate(TransitionYears, 0, 0)
+	for transition := NextTransition(start); !transition.IsZero() && transition.Before(end); transition = NextTransition(transition) {
+		expected, err := reference(transition.Add(-window))
+		if err != nil {
+			return false, err
+		}
+		actual, err := next(transition.Add(-window))
+		if err != nil {
+			return false, err
+		}
+		if !actual.Equal(expected) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ZoneName returns the IANA name of loc, or an empty string if loc is not in the IANA database, as is the case for
+// zones created with time.FixedZone. time.Local is named "Local", which would be read as a different zone on another
+// host, so it is also treated as unnamed.
+func ZoneName(loc *time.Location) string {
+	if loc == time.Local || loc.String() == "Local" {
+		return ""
+	}
+	if _, err := time.LoadLocation(loc.String()); err != nil {
+		return ""
+	}
+	return loc.String()
+}
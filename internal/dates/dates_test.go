@@ -0,0 +1,41 @@
+package dates
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFloorDiv(t *testing.T) {
+	var tests = []struct {
+		a, b, q, m int
+	}{
+		{a: 7, b: 12, q: 0, m: 7},
+		{a: 12, b: 12, q: 1, m: 0},
+		{a: -1, b: 12, q: -1, m: 11},
+		{a: -12, b: 12, q: -1, m: 0},
+		{a: -13, b: 12, q: -2, m: 11},
+	}
+	for _, test := range tests {
+		if q, m := FloorDiv(test.a, test.b), Mod(test.a, test.b); q != test.q || m != test.m {
+			t.Errorf("%d / %d: expected %d remainder %d, got %d remainder %d", test.a, test.b, test.q, test.m, q, m)
+		}
+	}
+}
+
+func TestDayNumber(t *testing.T) {
+	tokyo := time.FixedZone("", 9*60*60)
+	var tests = []struct {
+		in       time.Time
+		expected int
+	}{
+		{in: time.Date(1970, time.January, 1, 23, 0, 0, 0, time.UTC), expected: 0},
+		{in: time.Date(1969, time.December, 31, 1, 0, 0, 0, time.UTC), expected: -1},
+		// The date is taken in the zone of the time, not in UTC
+		{in: time.Date(1970, time.January, 2, 1, 0, 0, 0, tokyo), expected: 1},
+	}
+	for _, test := range tests {
+		if n := DayNumber(test.in); n != test.expected {
+			t.Errorf("%v: expected %d, got %d", test.in, test.expected, n)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package meetingtime
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// floatingLayout is the layout for times in floating schedules, which are independent of any time zone.
+const floatingLayout = "2006-01-02T15:04:05.999999999"
+
+// scheduleJSON is the JSON representation of a Schedule
+type scheduleJSON struct {
+	Type      ScheduleType `json:"type"`
+	First     string       `json:"first"`
+	Zone      string       `json:"zone,omitempty"`
+	Frequency uint         `json:"frequency,omitempty"`
+	Start     string       `json:"start,omitempty"`
+	Unbounded bool         `json:"unbounded,omitempty"`
+	Gap       DSTPolicy    `json:"gap,omitempty"`
+	Overlap   DSTPolicy    `json:"overlap,omitempty"`
+	Floating  bool         `json:"floating,omitempty"`
+}
+
+/*
+MarshalJSON implements json.Marshaler.
+
+The type is encoded by name, and First as an RFC 3339 time in the Schedule's time zone, along with the
+IANA name of that zone. Zones that are not in the IANA database, such as those created with time.FixedZone,
+and time.Local, whose zone depends on the host, are recorded only by their offset. For example:
+
+	{"type":"weekly","first":"2016-01-04T09:00:00Z","zone":"Europe/London","frequency":2}
+
+Floating schedules have no time zone, so times are encoded without an offset.
+*/
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	out := scheduleJSON{
+		Type:      s.Type,
+		Frequency: s.Frequency,
+		Unbounded: s.Unbounded,
+		Gap:       s.Gap,
+		Overlap:   s.Overlap,
+		Floating:  s.Floating,
+	}
+	if s.Floating {
+		out.First = s.First.Format(floatingLayout)
+		if !s.Start.IsZero() {
+			out.Start = s.Start.Format(floatingLayout)
+		}
+	} else {
+		loc := s.location()
+		out.Zone = dates.ZoneName(loc)
+		out.First = s.First.In(loc).Format(time.RFC3339Nano)
+		if !s.Start.IsZero() {
+			out.Start = s.Start.In(loc).Format(time.RFC3339Nano)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the format produced by MarshalJSON.
+// The decoded Schedule is validated before being returned.
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var in scheduleJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	out := Schedule{
+		Type:      in.Type,
+		Frequency: in.Frequency,
+		Unbounded: in.Unbounded,
+		Gap:       in.Gap,
+		Overlap:   in.Overlap,
+		Floating:  in.Floating,
+	}
+	var err error
+	out.First, err = parseScheduleTime(in.First, in.Zone, in.Floating)
+	if err != nil {
+		return err
+	}
+	if in.Start != "" {
+		out.Start, err = parseScheduleTime(in.Start, in.Zone, in.Floating)
+		if err != nil {
+			return err
+		}
+	}
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*s = out
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an array of Schedules.
+// The decoded slice is validated before being returned, so must not be empty.
+func (schedules *ScheduleSlice) UnmarshalJSON(data []byte) error {
+	var in []Schedule
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	out := ScheduleSlice(in)
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*schedules = out
+	return nil
+}
+
+// parseScheduleTime parses a time as encoded by MarshalJSON, in the named time zone.
+// If no zone is named, the time is given a fixed zone with the offset it was encoded with.
+func parseScheduleTime(value, zone string, floating bool) (time.Time, error) {
+	if floating {
+		return time.Parse(floatingLayout, value)
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if zone != "" {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(loc), nil
+	}
+	if _, offset := t.Zone(); offset != 0 {
+		return t.In(time.FixedZone("", offset)), nil
+	}
+	return t.UTC(), nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the slice as an array of Schedules.
+// The slice is validated first, as it is when decoded.
+func (schedules ScheduleSlice) MarshalJSON() ([]byte, error) {
+	if err := schedules.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal([]Schedule(schedules))
+}
+
+// seriesJSON is the JSON representation of a Series
+type seriesJSON struct {
+	Schedules []Schedule `json:"schedules,omitempty"`
+	Cancelled []string   `json:"cancelled,omitempty"`
+	Added     []string   `json:"added,omitempty"`
+	Moved     []moveJSON `json:"moved,omitempty"`
+}
+
+// moveJSON is the JSON representation of a Move
+type moveJSON struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+/*
+MarshalJSON implements json.Marshaler, encoding Schedules as for ScheduleSlice, and changed meetings as
+RFC 3339 times. For floating Series, times are encoded without an offset. For example:
+
+	{"schedules":[{"type":"weekly","first":"2016-01-04T09:00:00Z","zone":"Europe/London","frequency":1}],"cancelled":["2016-01-11T09:00:00Z"]}
+*/
+func (s Series) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	layout := time.RFC3339Nano
+	if s.floating() {
+		layout = floatingLayout
+	}
+	out := seriesJSON{Schedules: s.Schedules}
+	for _, c := range s.Cancelled {
+		out.Cancelled = append(out.Cancelled, c.Format(layout))
+	}
+	for _, a := range s.Added {
+		out.Added = append(out.Added, a.Format(layout))
+	}
+	for _, m := range s.Moved {
+		out.Moved = append(out.Moved, moveJSON{From: m.From.Format(layout), To: m.To.Format(layout)})
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the format produced by MarshalJSON.
+// The decoded Series is validated before being returned.
+func (s *Series) UnmarshalJSON(data []byte) error {
+	var in seriesJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	out := Series{Schedules: in.Schedules}
+	var err error
+	if out.Cancelled, err = parseSeriesTimes(in.Cancelled, out.floating()); err != nil {
+		return err
+	}
+	if out.Added, err = parseSeriesTimes(in.Added, out.floating()); err != nil {
+		return err
+	}
+	for _, m := range in.Moved {
+		times, err := parseSeriesTimes([]string{m.From, m.To}, out.floating())
+		if err != nil {
+			return err
+		}
+		out.Moved = append(out.Moved, Move{From: times[0], To: times[1]})
+	}
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*s = out
+	return nil
+}
+
+func parseSeriesTimes(values []string, floating bool) ([]time.Time, error) {
+	var out []time.Time
+	for _, value := range values {
+		t, err := parseScheduleTime(value, "", floating)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
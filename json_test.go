@@ -0,0 +1,221 @@
+package meetingtime
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScheduleJSON(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	var tests = []struct {
+		name     string
+		schedule Schedule
+		expected string
+	}{
+		{
+			name:     "Weekly",
+			schedule: NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 2),
+			expected: `{"type":"weekly","first":"2016-01-04T09:00:00Z","zone":"Europe/London","frequency":2}`,
+		},
+		{
+			name:     "Monthly by weekday in summer time",
+			schedule: NewMonthlyScheduleByWeekday(time.Date(2016, time.June, 14, 19, 30, 0, 0, london)),
+			expected: `{"type":"monthly-by-weekday","first":"2016-06-14T19:30:00+01:00","zone":"Europe/London","frequency":1}`,
+		},
+		{
+			name: "Explicit location",
+			schedule: Schedule{
+				Type:      Daily,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Location:  mustLoadLocation(t, "America/New_York"),
+			},
+			expected: `{"type":"daily","first":"2016-01-04T04:00:00-05:00","zone":"America/New_York","frequency":1}`,
+		},
+		{
+			name: "Start, unbounded and DST policies",
+			schedule: Schedule{
+				Type:      Yearly,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Start:     time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Unbounded: true,
+				Gap:       DSTSkip,
+				Overlap:   DSTShiftForward,
+			},
+			expected: `{"type":"yearly","first":"2016-01-04T09:00:00Z","zone":"UTC","frequency":1,"start":"2017-01-01T00:00:00Z","unbounded":true,"gap":"skip","overlap":"shift-forward"}`,
+		},
+		{
+			name:     "Fixed zone",
+			schedule: NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.FixedZone("", 9*60*60)), 1),
+			expected: `{"type":"daily","first":"2016-01-04T09:00:00+09:00","frequency":1}`,
+		},
+		{
+			name: "Floating",
+			schedule: Schedule{
+				Type:      Daily,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Floating:  true,
+			},
+			expected: `{"type":"daily","first":"2016-01-04T09:00:00","frequency":1,"floating":true}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := json.Marshal(test.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != test.expected {
+				t.Errorf("marshal: expected %s got %s", test.expected, out)
+			}
+			var decoded Schedule
+			if err := json.Unmarshal(out, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			// Decoding gives First in the Schedule's time zone, so compare meetings rather than fields
+			in := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+			expected, expectedErr := test.schedule.Next(in)
+			next, err := decoded.Next(in)
+			if err != expectedErr || !next.Equal(expected) {
+				t.Errorf("decoded schedule: expected '%v' got '%v', %v", expected, next, err)
+			}
+			if decoded.Type != test.schedule.Type || decoded.Frequency != test.schedule.Frequency {
+				t.Errorf("decoded schedule: expected %+v got %+v", test.schedule, decoded)
+			}
+		})
+	}
+}
+
+func TestScheduleJSONErrors(t *testing.T) {
+	var tests = []struct {
+		name        string
+		in          string
+		expectedErr error
+	}{
+		{
+			name:        "Unknown type",
+			in:          `{"type":"hourly","first":"2016-01-04T09:00:00Z","frequency":1}`,
+			expectedErr: ErrUnknownScheduleType,
+		},
+		{
+			name:        "Zero frequency",
+			in:          `{"type":"daily","first":"2016-01-04T09:00:00Z"}`,
+			expectedErr: ErrZeroFrequency,
+		},
+		{
+			name:        "Unknown DST policy",
+			in:          `{"type":"daily","first":"2016-01-04T09:00:00Z","frequency":1,"gap":"ignore"}`,
+			expectedErr: ErrUnknownDSTPolicy,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var s Schedule
+			if err := json.Unmarshal([]byte(test.in), &s); err != test.expectedErr {
+				t.Errorf("expected '%v' got '%v'", test.expectedErr, err)
+			}
+		})
+	}
+
+	var s Schedule
+	if err := json.Unmarshal([]byte(`{"type":"daily","first":"2016-01-04T09:00:00Z","zone":"Nowhere/Special","frequency":1}`), &s); err == nil {
+		t.Errorf("expected an error for an unknown zone")
+	}
+}
+
+func TestScheduleSliceJSON(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	out, err := json.Marshal(schedules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"type":"monthly-by-weekday","first":"2016-09-05T19:00:00Z","zone":"UTC","frequency":1},{"type":"monthly-by-weekday","first":"2016-09-19T19:00:00Z","zone":"UTC","frequency":1}]`
+	if string(out) != expected {
+		t.Errorf("marshal: expected %s got %s", expected, out)
+	}
+	var decoded ScheduleSlice
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || !decoded[1].First.Equal(schedules[1].First) {
+		t.Errorf("decoded: expected %v got %v", schedules, decoded)
+	}
+	if err := json.Unmarshal([]byte(`[]`), &decoded); err != ErrEmptySchedule {
+		t.Errorf("expected '%v' got '%v'", ErrEmptySchedule, err)
+	}
+	if _, err := json.Marshal(ScheduleSlice{}); !errors.Is(err, ErrEmptySchedule) {
+		t.Errorf("expected '%v' got '%v'", ErrEmptySchedule, err)
+	}
+}
+
+func TestLocalZone(t *testing.T) {
+	// time.Local differs between hosts, so it is encoded by its offset rather than by name
+	s := NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.Local), 1)
+	out, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(out), "Local") || strings.Contains(string(text), "Local") {
+		t.Errorf("expected no zone name, got %s and %s", out, text)
+	}
+	var decoded Schedule
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.First.Equal(s.First) {
+		t.Errorf("decoded: expected %v got %v", s.First, decoded.First)
+	}
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.First.Equal(s.First) {
+		t.Errorf("decoded text: expected %v got %v", s.First, decoded.First)
+	}
+}
+
+func TestSeriesJSON(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	series := Series{
+		Schedules: ScheduleSlice{NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 1)},
+		Cancelled: []time.Time{time.Date(2016, time.January, 11, 9, 0, 0, 0, london)},
+		Added:     []time.Time{time.Date(2016, time.June, 15, 9, 0, 0, 0, london)},
+		Moved:     []Move{{From: time.Date(2016, time.January, 18, 9, 0, 0, 0, london), To: time.Date(2016, time.January, 19, 14, 0, 0, 0, london)}},
+	}
+	expected := `{"schedules":[{"type":"weekly","first":"2016-01-04T09:00:00Z","zone":"Europe/London","frequency":1}],` +
+		`"cancelled":["2016-01-11T09:00:00Z"],"added":["2016-06-15T09:00:00+01:00"],` +
+		`"moved":[{"from":"2016-01-18T09:00:00Z","to":"2016-01-19T14:00:00Z"}]}`
+	out, err := json.Marshal(series)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Errorf("expected %s got %s", expected, out)
+	}
+	var decoded Series
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Cancelled[0].Equal(series.Cancelled[0]) || !decoded.Added[0].Equal(series.Added[0]) || decoded.Schedules[0].String() != series.Schedules[0].String() {
+		t.Errorf("expected %v got %v", series, decoded)
+	}
+	if !decoded.Moved[0].From.Equal(series.Moved[0].From) || !decoded.Moved[0].To.Equal(series.Moved[0].To) {
+		t.Errorf("expected move %v got %v", series.Moved[0], decoded.Moved[0])
+	}
+
+	// Decoded series are validated
+	if err := json.Unmarshal([]byte(`{"schedules":[{"type":"weekly","first":"2016-01-04T09:00:00Z","frequency":1}],"cancelled":["2016-01-05T09:00:00Z"]}`), &decoded); err != ErrNotScheduled {
+		t.Errorf("expected '%v' got '%v'", ErrNotScheduled, err)
+	}
+}
@@ -0,0 +1,8 @@
+/*
+Package parse reads English descriptions of meeting schedules, such as "every 2nd Tuesday at 7pm" or
+"first and third Monday of each month", into Schedules from the meetingtime package.
+
+It understands the descriptions generated by the describe package, so a Schedule described by describe.Schedule
+can be parsed back into an identical Schedule.
+*/
+package parse
@@ -0,0 +1,27 @@
+package parse
+
+import "fmt"
+
+type errorStr string
+
+func (e errorStr) Error() string { return string(e) }
+
+// ErrUnrecognized indicates that a phrase could not be read as a schedule.
+// It is wrapped by errors describing the problem in more detail.
+const ErrUnrecognized = errorStr("schedule not recognized")
+
+// ErrUnsupported indicates that a phrase describes meetings that cannot be held by Schedules, such as
+// "last Friday of the month". It is wrapped by errors describing the problem in more detail.
+const ErrUnsupported = errorStr("schedule not supported")
+
+// ErrMultipleSchedules indicates that a phrase passed to Schedule needs more than one Schedule to express,
+// such as "every Monday and Wednesday". Use ScheduleSlice for these phrases.
+const ErrMultipleSchedules = errorStr("phrase describes more than one schedule")
+
+func unrecognized(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrUnrecognized}, args...)...)
+}
+
+func unsupported(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrUnsupported}, args...)...)
+}
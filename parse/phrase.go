@@ -0,0 +1,423 @@
+package parse
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// phrase holds the parts of a schedule read from a description
+type phrase struct {
+	unit      unit
+	frequency uint // zero if not given
+	weekdays  []time.Weekday
+	ordinals  []int // weeks of the month, for days of the week such as "1st and 3rd Monday"
+	monthDays []int
+	month     time.Month // with day, for a date in every year such as "March 3"
+	day       int
+	clock     *clock
+	start     *time.Time
+}
+
+func (ph phrase) hasDays() bool {
+	return ph.weekdays != nil || ph.monthDays != nil || ph.day != 0
+}
+
+// parser reads a phrase from the words of a description, resolving dates against ref
+type parser struct {
+	tokens []string
+	pos    int
+	ref    time.Time
+	phrase phrase
+}
+
+func tokenize(text string) []string {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+	for i, t := range tokens {
+		t = strings.TrimSuffix(t, ".")
+		switch t {
+		case "a.m":
+			t = "am"
+		case "p.m":
+			t = "pm"
+		}
+		tokens[i] = t
+	}
+	return tokens
+}
+
+func (p *parser) peek(n int) string {
+	if p.pos+n < len(p.tokens) {
+		return p.tokens[p.pos+n]
+	}
+	return ""
+}
+
+// accept moves past the next token if it is one of the given words
+func (p *parser) accept(words ...string) bool {
+	for _, w := range words {
+		if p.peek(0) == w {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.tokens) {
+		return unrecognized("unexpected end of phrase")
+	}
+	return unrecognized("unexpected %q", p.tokens[p.pos])
+}
+
+func (p *parser) parse() error {
+	if len(p.tokens) == 0 {
+		return unrecognized("empty phrase")
+	}
+	if err := p.recurrence(); err != nil {
+		return err
+	}
+	for p.pos < len(p.tokens) {
+		if err := p.clause(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recurrence reads the opening of a phrase, such as "every other week", "monthly", "every Monday" or "the first Monday".
+func (p *parser) recurrence() error {
+	if a, ok := adverbs[p.peek(0)]; ok {
+		p.pos++
+		p.phrase.unit, p.phrase.frequency = a.unit, a.n
+		return nil
+	}
+	if p.accept("every", "each") {
+		if err := p.interval(); err != nil {
+			return err
+		}
+		if u, ok := units[p.peek(0)]; ok {
+			p.pos++
+			p.phrase.unit = u.unit
+			if p.phrase.frequency == 0 {
+				p.phrase.frequency = 1
+			}
+			p.phrase.frequency *= u.n
+			return nil
+		}
+	} else {
+		p.accept("the")
+	}
+	return p.days()
+}
+
+// interval reads the number of periods between meetings, as in "every 2 weeks", "every third month" or "every other Tuesday".
+func (p *parser) interval() error {
+	if p.accept("other") {
+		p.phrase.frequency = 2
+		return nil
+	}
+	if n, ok := number(p.peek(0)); ok {
+		if n == 0 {
+			return unrecognized("meetings cannot repeat every 0 %v", p.peek(1))
+		}
+		p.pos++
+		p.phrase.frequency = n
+		return nil
+	}
+	// An ordinal is an interval before a unit, and a week of the month before a day of the week
+	if n, ok := ordinal(p.peek(0)); ok && n > 0 {
+		if _, isUnit := units[p.peek(1)]; isUnit {
+			p.pos++
+			p.phrase.frequency = uint(n)
+		}
+	}
+	return nil
+}
+
+// days reads the days of the week on which meetings are held, such as "Monday and Wednesday", "weekdays" or "2nd Tuesday".
+func (p *parser) days() error {
+	if p.phrase.hasDays() {
+		return unrecognized("days of meetings are given more than once")
+	}
+	switch {
+	case p.accept("weekday", "weekdays"):
+		p.phrase.weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+		return nil
+	case p.accept("weekend", "weekends"):
+		p.phrase.weekdays = []time.Weekday{time.Saturday, time.Sunday}
+		return nil
+	}
+	if weekdays := p.weekdays(); weekdays != nil {
+		p.phrase.weekdays = weekdays
+		return nil
+	}
+	ok, err := p.ordinalWeekday()
+	if !ok && err == nil {
+		return p.unexpected()
+	}
+	return err
+}
+
+// weekdays reads a list of days of the week, such as "Monday, Wednesday and Friday"
+func (p *parser) weekdays() []time.Weekday {
+	var weekdays []time.Weekday
+	for {
+		w, ok := weekdayNames[p.peek(0)]
+		if !ok {
+			return weekdays
+		}
+		p.pos++
+		weekdays = append(weekdays, w)
+		if _, more := weekdayNames[p.peek(1)]; more && (p.peek(0) == "and" || p.peek(0) == "&") {
+			p.pos++
+		}
+	}
+}
+
+// ordinalWeekday reads days of the week in each month, such as "1st and 3rd Monday". It reads nothing and returns
+// false if the ordinals are not followed by a day of the week.
+func (p *parser) ordinalWeekday() (bool, error) {
+	start := p.pos
+	var ordinals []int
+	for {
+		n, ok := ordinal(p.peek(0))
+		if !ok {
+			break
+		}
+		p.pos++
+		ordinals = append(ordinals, n)
+		if _, more := ordinal(p.peek(1)); !more || (p.peek(0) != "and" && p.peek(0) != "&") {
+			break
+		}
+		p.pos++
+	}
+	w, ok := weekdayNames[p.peek(0)]
+	if len(ordinals) == 0 || !ok {
+		p.pos = start
+		return false, nil
+	}
+	p.pos++
+	for _, n := range ordinals {
+		if n < 0 {
+			return true, unsupported("schedules cannot hold meetings on the last %v of the month", w)
+		}
+		if n > 5 {
+			return true, unrecognized("a month cannot have a %d%s %v", n, suffix(n), w)
+		}
+	}
+	p.phrase.ordinals, p.phrase.weekdays = ordinals, []time.Weekday{w}
+	return true, nil
+}
+
+// clause reads a part of a phrase following the recurrence, such as "on Monday", "at 7pm" or "starting March 3".
+func (p *parser) clause() error {
+	switch {
+	case p.accept("and"):
+		return nil
+	case p.accept("on"):
+		return p.on()
+	case p.accept("of", "each", "every", "per", "a"):
+		p.accept("each", "every", "the")
+		if !p.accept("month") {
+			return p.unexpected()
+		}
+		return p.setUnit(months)
+	case p.accept("at"):
+		ok, err := p.clock(true)
+		if !ok && err == nil {
+			return p.unexpected()
+		}
+		return err
+	case p.accept("starting", "from", "beginning", "commencing"):
+		p.accept("on", "from")
+		return p.start()
+	}
+	if ok, err := p.clock(false); ok || err != nil {
+		return err
+	}
+	return p.unexpected()
+}
+
+func (p *parser) setUnit(u unit) error {
+	if p.phrase.unit != noUnit && p.phrase.unit != u {
+		return unrecognized("meetings cannot repeat by both %v and %v", p.phrase.unit, u)
+	}
+	p.phrase.unit = u
+	return nil
+}
+
+// on reads the days following "on", such as "Monday", "the 1st and 15th", "the 2nd Tuesday" or "March 3".
+func (p *parser) on() error {
+	if p.phrase.hasDays() {
+		return unrecognized("days of meetings are given more than once")
+	}
+	p.accept("the")
+	month, day, ok, err := p.monthDay()
+	if err != nil {
+		return err
+	}
+	if ok {
+		p.phrase.month, p.phrase.day = month, day
+		return nil
+	}
+	if _, ok := ordinal(p.peek(0)); !ok {
+		return p.days()
+	}
+	if ok, err := p.ordinalWeekday(); ok || err != nil {
+		return err
+	}
+	for {
+		n, ok := ordinal(p.peek(0))
+		if !ok {
+			return p.unexpected()
+		}
+		if n < 0 || n > 31 {
+			if n < 0 {
+				return unsupported("schedules cannot hold meetings on the last day of the month")
+			}
+			return unrecognized("a month cannot have a %d%s day", n, suffix(n))
+		}
+		p.pos++
+		p.phrase.monthDays = append(p.phrase.monthDays, n)
+		if _, more := ordinal(p.peek(1)); !more || (p.peek(0) != "and" && p.peek(0) != "&") {
+			return nil
+		}
+		p.pos++
+	}
+}
+
+// monthDay reads a day of the year, such as "March 3", "Mar 03" or "3rd of March". It reads nothing and returns
+// false if the next words are not a day of the year.
+func (p *parser) monthDay() (time.Month, int, bool, error) {
+	start := p.pos
+	if m, ok := monthNames[p.peek(0)]; ok {
+		if d, ok := dayOfMonth(p.peek(1)); ok {
+			p.pos += 2
+			return m, d, true, checkDay(m, d)
+		}
+	}
+	if d, ok := dayOfMonth(p.peek(0)); ok {
+		p.pos++
+		p.accept("of")
+		if m, ok := monthNames[p.peek(0)]; ok {
+			p.pos++
+			return m, d, true, checkDay(m, d)
+		}
+	}
+	p.pos = start
+	return 0, 0, false, nil
+}
+
+func checkDay(month time.Month, day int) error {
+	// 2000 was a leap year, so has every day
+	if day > daysIn(2000, month) {
+		return unrecognized("%v has no day %d", month, day)
+	}
+	return nil
+}
+
+// clock reads the time of meetings, returning false if the next words are not a time
+func (p *parser) clock(bare bool) (bool, error) {
+	c, n, err := parseClock(p.peek(0), p.peek(1), bare)
+	if n == 0 || err != nil {
+		return false, err
+	}
+	if p.phrase.clock != nil {
+		return true, unrecognized("time of meetings is given more than once")
+	}
+	p.pos += n
+	p.phrase.clock = &c
+	return true, nil
+}
+
+// start reads the date of the first meeting
+func (p *parser) start() error {
+	if p.phrase.start != nil {
+		return unrecognized("start date is given more than once")
+	}
+	d, err := p.date()
+	if err != nil {
+		return err
+	}
+	p.phrase.start = &d
+	return nil
+}
+
+/*
+date reads a date, such as "March 3", "Fri Jan 01 2016", "3rd of March 2017", "2017-03-03", "Monday" or "tomorrow".
+Dates without a year are the next such date on or after the reference date, and a day of the week alone is the next
+such day.
+*/
+func (p *parser) date() (time.Time, error) {
+	y, m, d := p.ref.Date()
+	loc := p.ref.Location()
+	today := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	switch {
+	case p.accept("today"):
+		return today, nil
+	case p.accept("tomorrow"):
+		return today.AddDate(0, 0, 1), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", p.peek(0), loc); err == nil {
+		p.pos++
+		return t, nil
+	}
+
+	p.accept("the")
+	weekday, hasWeekday := weekdayNames[p.peek(0)]
+	if hasWeekday {
+		p.pos++
+	}
+	month, day, ok, err := p.monthDay()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		if !hasWeekday {
+			return time.Time{}, p.unexpected()
+		}
+		return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7), nil
+	}
+
+	var t time.Time
+	if year, ok := year(p.peek(0)); ok {
+		p.pos++
+		if t = time.Date(year, month, day, 0, 0, 0, 0, loc); t.Day() != day {
+			return time.Time{}, unrecognized("%v %d is not a date in %d", month, day, year)
+		}
+	} else {
+		// February 29th may be up to 8 years away
+		for i := 0; i <= 8; i++ {
+			t = time.Date(y+i, month, day, 0, 0, 0, 0, loc)
+			if t.Day() == day && !t.Before(today) {
+				break
+			}
+		}
+	}
+	if hasWeekday && t.Weekday() != weekday {
+		return time.Time{}, unrecognized("%v is not a %v", t.Format("Jan 02 2006"), weekday)
+	}
+	return t, nil
+}
+
+func suffix(x int) string {
+	switch x % 10 {
+	case 1:
+		if x%100 != 11 {
+			return "st"
+		}
+	case 2:
+		if x%100 != 12 {
+			return "nd"
+		}
+	case 3:
+		if x%100 != 13 {
+			return "rd"
+		}
+	}
+	return "th"
+}
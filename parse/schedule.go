@@ -0,0 +1,224 @@
+package parse
+
+import (
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+/*
+ScheduleSlice reads an English description of a meeting schedule, such as "every 2nd Tuesday at 7pm",
+"every other week on Monday starting March 3", "first and third Monday of each month" or "every 6 months",
+including the descriptions generated by the describe package.
+
+Each day named in the description has its own Schedule, so "first and third Monday of each month" becomes two
+MonthlyByWeekday Schedules, and "every other week on Monday and Wednesday" two Weekly Schedules, with their first
+meetings in the same week.
+
+Meetings are held in the location of ref. Where a description gives no time, meetings are held at ref's time of day,
+to the minute. Where it gives no start date, the first meeting is the first described meeting at or after ref;
+otherwise it is the first on or after the start date. Start dates without a year are the next such date on or after
+ref.
+
+Descriptions that cannot be read return an error wrapping ErrUnrecognized, and those of meetings that Schedules
+cannot hold, such as "last Friday of the month", an error wrapping ErrUnsupported. Descriptions separated by
+semicolons, as describe.ScheduleSlice lists them, are read into a single ScheduleSlice.
+*/
+func ScheduleSlice(text string, ref time.Time) (meetingtime.ScheduleSlice, error) {
+	var schedules meetingtime.ScheduleSlice
+	// Lists of descriptions are separated by semicolons
+	for i, part := range strings.Split(text, ";") {
+		p := parser{tokens: tokenize(part), ref: ref}
+		if i > 0 {
+			p.accept("and")
+		}
+		if err := p.parse(); err != nil {
+			return nil, err
+		}
+		s, err := p.phrase.schedules(ref)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, s...)
+	}
+	return schedules, nil
+}
+
+// Schedule reads an English description of a single meeting schedule, as ScheduleSlice does.
+// It returns ErrMultipleSchedules if the description needs more than one Schedule to express.
+func Schedule(text string, ref time.Time) (meetingtime.Schedule, error) {
+	schedules, err := ScheduleSlice(text, ref)
+	if err != nil {
+		return meetingtime.Schedule{}, err
+	}
+	if len(schedules) > 1 {
+		return meetingtime.Schedule{}, ErrMultipleSchedules
+	}
+	return schedules[0], nil
+}
+
+// schedules converts the phrase into Schedules, with their first meetings found from ref
+func (ph phrase) schedules(ref time.Time) (meetingtime.ScheduleSlice, error) {
+	f := finder{
+		location:  ref.Location(),
+		clock:     clock{hour: ref.Hour(), minute: ref.Minute()},
+		notBefore: ref,
+		frequency: 1,
+	}
+	if ph.clock != nil {
+		f.clock = *ph.clock
+	}
+	if ph.start != nil {
+		f.notBefore = *ph.start
+	}
+	if ph.frequency > 0 {
+		f.frequency = int(ph.frequency)
+	}
+
+	var schedules meetingtime.ScheduleSlice
+	switch {
+	case ph.ordinals != nil:
+		if ph.unit != noUnit && ph.unit != months || f.frequency > 1 {
+			return nil, unsupported("schedules can only hold meetings on a day of the week in every month")
+		}
+		for _, n := range ph.ordinals {
+			first, ok := f.monthlyByWeekday(n, ph.weekdays[0])
+			if !ok {
+				return nil, unsupported("no month has a %d%s %v", n, suffix(n), ph.weekdays[0])
+			}
+			schedules = append(schedules, meetingtime.NewMonthlyScheduleByWeekday(first))
+		}
+	case ph.weekdays != nil:
+		if ph.unit != noUnit && ph.unit != weeks {
+			return nil, unsupported("meetings on days of the week cannot repeat by %v", ph.unit)
+		}
+		// Meetings on several days every few weeks are held in the same weeks
+		step := 7
+		if len(ph.weekdays) > 1 {
+			step *= f.frequency
+		}
+		for _, w := range ph.weekdays {
+			schedules = append(schedules, meetingtime.NewWeeklySchedule(f.weekly(w, step), uint(f.frequency)))
+		}
+	case ph.monthDays != nil:
+		if ph.unit != noUnit && ph.unit != months {
+			return nil, unsupported("meetings on days of the month cannot repeat by %v", ph.unit)
+		}
+		for _, d := range ph.monthDays {
+			first, ok := f.monthly(d)
+			if !ok {
+				return nil, unsupported("no month of the schedule has a %d%s day", d, suffix(d))
+			}
+			schedules = append(schedules, meetingtime.NewMonthlySchedule(first, uint(f.frequency)))
+		}
+	case ph.day != 0:
+		if ph.unit != noUnit && ph.unit != years {
+			return nil, unsupported("meetings on a day of the year cannot repeat by %v", ph.unit)
+		}
+		first, ok := f.yearly(ph.month, ph.day)
+		if !ok {
+			return nil, unsupported("no year of the schedule has %v %d", ph.month, ph.day)
+		}
+		schedules = append(schedules, meetingtime.NewYearlySchedule(first, uint(f.frequency)))
+	default:
+		schedule, err := f.fromStart(ph.unit)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// finder finds the first meeting of a schedule at a time of day, on or after a time
+type finder struct {
+	location  *time.Location
+	clock     clock
+	notBefore time.Time
+	frequency int
+}
+
+func (f finder) at(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, f.clock.hour, f.clock.minute, 0, 0, f.location)
+}
+
+// fromStart returns a schedule repeating by a unit from the start date
+func (f finder) fromStart(u unit) (meetingtime.Schedule, error) {
+	y, m, d := f.notBefore.Date()
+	switch u {
+	case days:
+		first := f.at(y, m, d)
+		if first.Before(f.notBefore) {
+			first = f.at(y, m, d+1)
+		}
+		return meetingtime.NewDailySchedule(first, uint(f.frequency)), nil
+	case weeks:
+		return meetingtime.NewWeeklySchedule(f.weekly(f.notBefore.Weekday(), 7), uint(f.frequency)), nil
+	case months:
+		first, _ := f.monthly(d)
+		return meetingtime.NewMonthlySchedule(first, uint(f.frequency)), nil
+	case years:
+		first, _ := f.yearly(m, d)
+		return meetingtime.NewYearlySchedule(first, uint(f.frequency)), nil
+	}
+	return meetingtime.Schedule{}, unrecognized("no repetition is given, such as \"every week\"")
+}
+
+// weekly returns the day of the week in the week of notBefore, or step days later if that is too early.
+// Weeks start on Monday.
+func (f finder) weekly(w time.Weekday, step int) time.Time {
+	y, m, d := f.notBefore.Date()
+	monday := d - (int(f.notBefore.Weekday())+6)%7
+	first := f.at(y, m, monday+(int(w)+6)%7)
+	if first.Before(f.notBefore) {
+		first = f.at(y, m, monday+(int(w)+6)%7+step)
+	}
+	return first
+}
+
+// monthly returns the day in the month of notBefore, or in a month every frequency months after it.
+func (f finder) monthly(day int) (time.Time, bool) {
+	y, m, _ := f.notBefore.Date()
+	for i := 0; i < 4*12; i += f.frequency {
+		month := m + time.Month(i)
+		if day > daysIn(y, month) {
+			continue
+		}
+		if first := f.at(y, month, day); !first.Before(f.notBefore) {
+			return first, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// yearly returns the day in the year of notBefore, or in a year every frequency years after it.
+func (f finder) yearly(month time.Month, day int) (time.Time, bool) {
+	y := f.notBefore.Year()
+	for i := 0; i < 8*f.frequency+1; i += f.frequency {
+		if day > daysIn(y+i, month) {
+			continue
+		}
+		if first := f.at(y+i, month, day); !first.Before(f.notBefore) {
+			return first, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// monthlyByWeekday returns the nth day of the week in the month of notBefore, or the first month after it with one.
+func (f finder) monthlyByWeekday(n int, w time.Weekday) (time.Time, bool) {
+	y, m, _ := f.notBefore.Date()
+	for i := 0; i < 2*12; i++ {
+		month := m + time.Month(i)
+		firstDay := time.Date(y, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		day := 1 + (int(w)-int(firstDay)+7)%7 + 7*(n-1)
+		if day > daysIn(y, month) {
+			continue
+		}
+		if first := f.at(y, month, day); !first.Before(f.notBefore) {
+			return first, true
+		}
+	}
+	return time.Time{}, false
+}
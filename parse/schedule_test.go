@@ -0,0 +1,268 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/describe"
+)
+
+func TestScheduleSlice(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Thursday
+	ref := time.Date(2016, time.September, 1, 10, 0, 0, 0, london)
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, london)
+	}
+	var tests = []struct {
+		in       string
+		expected meetingtime.ScheduleSlice
+	}{
+		{
+			in:       "every 2nd Tuesday at 7pm",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(at(2016, time.September, 13, 19, 0))},
+		},
+		{
+			in:       "every other week on Monday starting March 3",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(at(2017, time.March, 6, 10, 0), 2)},
+		},
+		{
+			in: "first and third Monday of each month",
+			expected: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlyScheduleByWeekday(at(2016, time.September, 5, 10, 0)),
+				meetingtime.NewMonthlyScheduleByWeekday(at(2016, time.September, 19, 10, 0)),
+			},
+		},
+		{
+			in:       "every 6 months",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(ref, 6)},
+		},
+		{
+			in:       "every day at 9am",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewDailySchedule(at(2016, time.September, 2, 9, 0), 1)},
+		},
+		{
+			in: "Mondays, Wednesdays and Fridays at 18:30",
+			expected: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(2016, time.September, 5, 18, 30), 1),
+				meetingtime.NewWeeklySchedule(at(2016, time.September, 7, 18, 30), 1),
+				meetingtime.NewWeeklySchedule(at(2016, time.September, 2, 18, 30), 1),
+			},
+		},
+		{
+			in: "every other week on Monday and Wednesday at 9:15 p.m.",
+			expected: meetingtime.ScheduleSlice{
+				meetingtime.NewWeeklySchedule(at(2016, time.September, 12, 21, 15), 2),
+				meetingtime.NewWeeklySchedule(at(2016, time.September, 14, 21, 15), 2),
+			},
+		},
+		{
+			in: "monthly on the 1st and 15th at noon",
+			expected: meetingtime.ScheduleSlice{
+				meetingtime.NewMonthlySchedule(at(2016, time.September, 1, 12, 0), 1),
+				meetingtime.NewMonthlySchedule(at(2016, time.September, 15, 12, 0), 1),
+			},
+		},
+		{
+			in:       "every year on the 3rd of March at 9:30 am",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewYearlySchedule(at(2017, time.March, 3, 9, 30), 1)},
+		},
+		{
+			in:       "fortnightly on Thursday at 10am",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(ref, 2)},
+		},
+		{
+			in:       "every third week starting 2016-10-10",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewWeeklySchedule(at(2016, time.October, 10, 10, 0), 3)},
+		},
+		{
+			in:       "quarterly starting tomorrow at midnight",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewMonthlySchedule(at(2016, time.September, 2, 0, 0), 3)},
+		},
+		{
+			in:       "the 2nd Friday of the month, starting Monday",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewMonthlyScheduleByWeekday(at(2016, time.September, 9, 10, 0))},
+		},
+		{
+			in:       "every 2 years starting 29th of February",
+			expected: meetingtime.ScheduleSlice{meetingtime.NewYearlySchedule(at(2020, time.February, 29, 10, 0), 2)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			schedules, err := ScheduleSlice(test.in, ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(schedules) != len(test.expected) {
+				t.Fatalf("expected %d schedules, got %d", len(test.expected), len(schedules))
+			}
+			for i, expected := range test.expected {
+				if !sameSchedule(expected, schedules[i]) {
+					t.Errorf("schedule %d: expected %v got %v", i, expected, schedules[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleSliceErrors(t *testing.T) {
+	ref := time.Date(2016, time.September, 1, 10, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		in          string
+		expectedErr error
+	}{
+		{in: "", expectedErr: ErrUnrecognized},
+		{in: "sometimes", expectedErr: ErrUnrecognized},
+		{in: "every", expectedErr: ErrUnrecognized},
+		{in: "every 0 days", expectedErr: ErrUnrecognized},
+		{in: "every week at 25:00", expectedErr: ErrUnrecognized},
+		{in: "every day at 13pm", expectedErr: ErrUnrecognized},
+		{in: "every day at 7pm at 8pm", expectedErr: ErrUnrecognized},
+		{in: "every day starting Fri Sep 03 2016", expectedErr: ErrUnrecognized},
+		{in: "every year on February 30", expectedErr: ErrUnrecognized},
+		{in: "every 6th Monday", expectedErr: ErrUnrecognized},
+		{in: "every Monday on Tuesday", expectedErr: ErrUnrecognized},
+		{in: "every week of the month", expectedErr: ErrUnrecognized},
+		{in: "the last Friday of the month", expectedErr: ErrUnsupported},
+		{in: "monthly on the last day", expectedErr: ErrUnsupported},
+		{in: "every other 2nd Tuesday", expectedErr: ErrUnsupported},
+		{in: "every 2nd week on the 15th", expectedErr: ErrUnsupported},
+		{in: "every day on Monday", expectedErr: ErrUnsupported},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if _, err := ScheduleSlice(test.in, ref); !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected '%v' got '%v'", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestScheduleMultiple(t *testing.T) {
+	ref := time.Date(2016, time.September, 1, 10, 0, 0, 0, time.UTC)
+	if _, err := Schedule("every Monday and Wednesday", ref); err != ErrMultipleSchedules {
+		t.Errorf("expected '%v' got '%v'", ErrMultipleSchedules, err)
+	}
+}
+
+// Descriptions of schedules should parse to the same schedule
+func TestScheduleRoundTrip(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []meetingtime.Schedule{
+		meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), 1),
+		meetingtime.NewDailySchedule(time.Date(2016, time.January, 2, 0, 0, 0, 0, time.UTC), 5),
+		meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 3, 0, 0, 0, 0, time.UTC), 1),
+		meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 4, 0, 0, 0, 0, time.UTC), 4),
+		meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 5, 0, 0, 0, 0, time.UTC), 1),
+		meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC), 6),
+		meetingtime.NewYearlySchedule(time.Date(2016, time.January, 7, 0, 0, 0, 0, time.UTC), 1),
+		meetingtime.NewYearlySchedule(time.Date(2016, time.February, 29, 0, 0, 0, 0, time.UTC), 2),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 12, 0, 0, 0, 0, time.UTC)),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 7, 0, 0, 0, 0, time.UTC)),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 31, 0, 0, 0, 0, time.UTC)),
+		meetingtime.NewWeeklySchedule(time.Date(2016, time.November, 6, 13, 45, 0, 0, newYork), 2),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 13, 19, 0, 0, 0, newYork)),
+	}
+	for _, schedule := range tests {
+		description, err := describe.Schedule(schedule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(description, func(t *testing.T) {
+			// The reference time should only provide the location
+			ref := time.Date(2020, time.June, 15, 8, 0, 0, 0, schedule.First.Location())
+			parsed, err := Schedule(description, ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sameSchedule(schedule, parsed) {
+				t.Errorf("expected %v got %v", schedule, parsed)
+			}
+			again, err := describe.Schedule(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != description {
+				t.Errorf("expected %q got %q", description, again)
+			}
+		})
+	}
+}
+
+func sameSchedule(a, b meetingtime.Schedule) bool {
+	return a.Type == b.Type && a.First.Equal(b.First) && a.Frequency == b.Frequency &&
+		a.First.Location().String() == b.First.Location().String()
+}
+
+// Descriptions of schedule slices should parse to schedules with the same meetings
+func TestScheduleSliceRoundTrip(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2016, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	var tests = []meetingtime.ScheduleSlice{
+		{
+			meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 5, 19)),
+			meetingtime.NewMonthlyScheduleByWeekday(at(time.September, 19, 19)),
+		},
+		{
+			meetingtime.NewWeeklySchedule(at(time.September, 7, 9), 1),
+			meetingtime.NewWeeklySchedule(at(time.September, 9, 9), 1),
+			meetingtime.NewWeeklySchedule(at(time.September, 12, 9), 1),
+		},
+		{
+			meetingtime.NewWeeklySchedule(at(time.September, 5, 9), 2),
+			meetingtime.NewWeeklySchedule(at(time.September, 8, 9), 2),
+		},
+		{
+			meetingtime.NewMonthlySchedule(at(time.September, 1, 9), 3),
+			meetingtime.NewMonthlySchedule(at(time.September, 15, 9), 3),
+		},
+		{
+			meetingtime.NewDailySchedule(at(time.January, 1, 9), 1),
+			meetingtime.NewMonthlyScheduleByWeekday(at(time.January, 4, 0)),
+			meetingtime.NewWeeklySchedule(at(time.January, 5, 17), 1),
+			meetingtime.NewWeeklySchedule(at(time.January, 7, 17), 1),
+		},
+	}
+	for _, schedules := range tests {
+		description, err := describe.ScheduleSlice(schedules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(description, func(t *testing.T) {
+			parsed, err := ScheduleSlice(description, time.Date(2020, time.June, 15, 8, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatal(err)
+			}
+			again, err := describe.ScheduleSlice(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != description {
+				t.Errorf("expected %q got %q", description, again)
+			}
+			expected, actual := at(time.January, 1, 0), at(time.January, 1, 0)
+			for i := 0; i < 50; i++ {
+				if expected, err = schedules.Next(expected); err != nil {
+					t.Fatal(err)
+				}
+				if actual, err = parsed.Next(actual); err != nil {
+					t.Fatal(err)
+				}
+				if !actual.Equal(expected) {
+					t.Fatalf("meeting %d: expected %v got %v", i, expected, actual)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// unit is the period by which meetings repeat
+type unit int
+
+const (
+	noUnit unit = iota
+	days
+	weeks
+	months
+	years
+)
+
+func (u unit) String() string {
+	switch u {
+	case days:
+		return "days"
+	case weeks:
+		return "weeks"
+	case months:
+		return "months"
+	case years:
+		return "years"
+	}
+	return "none"
+}
+
+// period is a number of units, such as the two weeks of a fortnight
+type period struct {
+	unit unit
+	n    uint
+}
+
+var units = map[string]period{
+	"day":        {days, 1},
+	"days":       {days, 1},
+	"week":       {weeks, 1},
+	"weeks":      {weeks, 1},
+	"fortnight":  {weeks, 2},
+	"fortnights": {weeks, 2},
+	"month":      {months, 1},
+	"months":     {months, 1},
+	"quarter":    {months, 3},
+	"quarters":   {months, 3},
+	"year":       {years, 1},
+	"years":      {years, 1},
+}
+
+var adverbs = map[string]period{
+	"daily":       {days, 1},
+	"weekly":      {weeks, 1},
+	"biweekly":    {weeks, 2},
+	"fortnightly": {weeks, 2},
+	"monthly":     {months, 1},
+	"quarterly":   {months, 3},
+	"yearly":      {years, 1},
+	"annually":    {years, 1},
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday, "sundays": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday, "mondays": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday, "tuesdays": time.Tuesday,
+	"wed": time.Wednesday, "weds": time.Wednesday, "wednesday": time.Wednesday, "wednesdays": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday, "thursdays": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday, "fridays": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday, "saturdays": time.Saturday,
+}
+
+var monthNames = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var numberWords = map[string]uint{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+}
+
+// ordinalWords maps ordinal words to their values, with "last" as -1
+var ordinalWords = map[string]int{
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5, "last": -1,
+}
+
+var (
+	ordinalPattern = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)$`)
+	clockPattern   = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	yearPattern    = regexp.MustCompile(`^\d{4}$`)
+	dayPattern     = regexp.MustCompile(`^\d{1,2}$`)
+)
+
+// number reads a count, such as "3" or "three"
+func number(token string) (uint, bool) {
+	if n, ok := numberWords[token]; ok {
+		return n, true
+	}
+	n, err := strconv.ParseUint(token, 10, 16)
+	return uint(n), err == nil
+}
+
+// ordinal reads an ordinal, such as "3rd" or "third", returning -1 for "last"
+func ordinal(token string) (int, bool) {
+	if n, ok := ordinalWords[token]; ok {
+		return n, true
+	}
+	if match := ordinalPattern.FindStringSubmatch(token); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		return n, n > 0
+	}
+	return 0, false
+}
+
+// dayOfMonth reads a day in a date, such as "3", "03" or "3rd"
+func dayOfMonth(token string) (int, bool) {
+	if dayPattern.MatchString(token) {
+		n, _ := strconv.Atoi(token)
+		return n, n > 0
+	}
+	return ordinal(token)
+}
+
+func year(token string) (int, bool) {
+	if !yearPattern.MatchString(token) {
+		return 0, false
+	}
+	n, _ := strconv.Atoi(token)
+	return n, true
+}
+
+// clock is a time of day
+type clock struct {
+	hour, minute int
+}
+
+/*
+parseClock reads a time of day, such as "7pm", "7:30 pm", "19:00", "12:00AM" or "noon", from a token and the one
+following it, returning the number of tokens read. Bare hours, such as "7", are only read if bare is true.
+*/
+func parseClock(token, next string, bare bool) (clock, int, error) {
+	switch token {
+	case "noon", "midday":
+		return clock{hour: 12}, 1, nil
+	case "midnight":
+		return clock{}, 1, nil
+	}
+	match := clockPattern.FindStringSubmatch(token)
+	if match == nil {
+		return clock{}, 0, nil
+	}
+	n, suffix, read := 1, match[3], token
+	if suffix == "" && (next == "am" || next == "pm") {
+		n, suffix, read = 2, next, token+" "+next
+	}
+	if suffix == "" && match[2] == "" && !bare {
+		return clock{}, 0, nil
+	}
+
+	var c clock
+	c.hour, _ = strconv.Atoi(match[1])
+	if match[2] != "" {
+		c.minute, _ = strconv.Atoi(match[2])
+	}
+	if c.minute > 59 {
+		return clock{}, 0, unrecognized("%q is not a time", read)
+	}
+	switch suffix {
+	case "":
+		if c.hour > 23 {
+			return clock{}, 0, unrecognized("%q is not a time", read)
+		}
+	default:
+		if c.hour < 1 || c.hour > 12 {
+			return clock{}, 0, unrecognized("%q is not a time", read)
+		}
+		c.hour %= 12
+		if suffix == "pm" {
+			c.hour += 12
+		}
+	}
+	return c, n, nil
+}
+
+// daysIn returns the number of days in a month, which may be beyond December
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,67 @@
+package parse
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseClock(t *testing.T) {
+	var tests = []struct {
+		token    string
+		next     string
+		bare     bool
+		expected clock
+		n        int
+		err      error
+	}{
+		{token: "7pm", expected: clock{19, 0}, n: 1},
+		{token: "7", next: "pm", expected: clock{19, 0}, n: 2},
+		{token: "7:30am", expected: clock{7, 30}, n: 1},
+		{token: "12:00am", expected: clock{0, 0}, n: 1},
+		{token: "12pm", expected: clock{12, 0}, n: 1},
+		{token: "19:05", expected: clock{19, 5}, n: 1},
+		{token: "noon", expected: clock{12, 0}, n: 1},
+		{token: "midnight", expected: clock{0, 0}, n: 1},
+		{token: "7", bare: true, expected: clock{7, 0}, n: 1},
+		{token: "7"},
+		{token: "monday"},
+		{token: "24:00", err: ErrUnrecognized},
+		{token: "9:60", err: ErrUnrecognized},
+		{token: "0am", err: ErrUnrecognized},
+		{token: "13", next: "pm", err: ErrUnrecognized},
+	}
+	for _, test := range tests {
+		t.Run(test.token+" "+test.next, func(t *testing.T) {
+			c, n, err := parseClock(test.token, test.next, test.bare)
+			if !errors.Is(err, test.err) || (err == nil) != (test.err == nil) {
+				t.Fatalf("expected '%v' got '%v'", test.err, err)
+			}
+			if c != test.expected || n != test.n {
+				t.Errorf("expected %v (%d tokens) got %v (%d tokens)", test.expected, test.n, c, n)
+			}
+		})
+	}
+}
+
+// Errors quote the tokens that were read as a time
+func TestParseClockErrors(t *testing.T) {
+	var tests = []struct {
+		token    string
+		next     string
+		expected string
+	}{
+		{token: "25pm", expected: `"25pm" is not a time`},
+		{token: "13", next: "pm", expected: `"13 pm" is not a time`},
+		{token: "9:60", next: "pm", expected: `"9:60 pm" is not a time`},
+		{token: "24:00", next: "every", expected: `"24:00" is not a time`},
+	}
+	for _, test := range tests {
+		t.Run(test.token+" "+test.next, func(t *testing.T) {
+			_, _, err := parseClock(test.token, test.next, false)
+			if err == nil || !strings.HasSuffix(err.Error(), test.expected) {
+				t.Errorf("expected an error ending %s, got '%v'", test.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+/*
+Package rrule converts Schedules from the meetingtime package to and from iCalendar (RFC 5545) recurrence rules.
+
+Recurrences that cannot be expressed as Schedules, such as those ending after a number of meetings,
+can be evaluated directly with the Next and Previous methods of Recurrence.
+*/
+package rrule
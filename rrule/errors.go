@@ -0,0 +1,57 @@
+package rrule
+
+import "fmt"
+
+type errorStr string
+
+func (e errorStr) Error() string { return string(e) }
+
+// ErrUnbounded indicates that an unbounded Schedule was converted, which has no first meeting to use as DTSTART
+const ErrUnbounded = errorStr("unbounded schedules have no first meeting")
+
+// ErrShortMonth indicates that a Schedule has meetings on a day that some of its months do not have.
+// Schedules hold these meetings on the last day of the month, but recurrence rules omit them.
+const ErrShortMonth = errorStr("meetings on days missing from some months cannot be expressed as a recurrence rule")
+
+// ErrDSTPolicy indicates that a Schedule's DSTPolicy affects its meetings, and differs from that of recurrence rules,
+// which move meetings at skipped times later and hold meetings at repeated times at the earlier instant.
+const ErrDSTPolicy = errorStr("daylight saving policy cannot be expressed as a recurrence rule")
+
+// ErrShiftedStart indicates that the first meeting of a Schedule is moved by a daylight saving transition,
+// so the clock time of its meetings cannot be given in DTSTART.
+const ErrShiftedStart = errorStr("first meeting is moved by a daylight saving transition")
+
+// ErrFractionalSeconds indicates that a Schedule has meetings at fractions of a second, which recurrence rules cannot express
+const ErrFractionalSeconds = errorStr("recurrence rules cannot express fractions of a second")
+
+// ErrUnnamedZone indicates that a Schedule is in a time zone with daylight saving transitions, but no IANA name to use as TZID
+const ErrUnnamedZone = errorStr("time zone has no IANA name")
+
+// ErrInvalid indicates that a recurrence could not be parsed, or breaks the rules of RFC 5545.
+// It is wrapped by errors describing the problem in more detail.
+const ErrInvalid = errorStr("invalid recurrence")
+
+// ErrMultipleSchedules indicates that a Recurrence was converted to a single Schedule, but requires a ScheduleSlice
+const ErrMultipleSchedules = errorStr("recurrence requires more than one schedule")
+
+/*
+UnsupportedError reports a part of a recurrence that is valid, but cannot be evaluated by this package,
+or cannot be converted to a Schedule. Part identifies the property, parameter or rule part concerned,
+such as "BYWEEKNO" or "BYDAY=-1FR".
+*/
+type UnsupportedError struct {
+	Part   string
+	Reason string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("unsupported %s: %s", e.Part, e.Reason)
+}
+
+func unsupported(part, reason string) error {
+	return &UnsupportedError{Part: part, Reason: reason}
+}
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalid}, args...)...)
+}
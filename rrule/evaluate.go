@@ -0,0 +1,371 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// Validate checks that the Recurrence follows the rules of RFC 5545 and can be evaluated by this package.
+func (r Recurrence) Validate() error {
+	rule := r.Rule
+	if _, ok := frequencyNames[rule.Freq]; !ok {
+		return invalid("unknown frequency %v", rule.Freq)
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return invalid("COUNT and UNTIL cannot both be given")
+	}
+	for _, day := range rule.ByDay {
+		if day.Day < time.Sunday || day.Day > time.Saturday {
+			return invalid("unknown day of the week %v", day.Day)
+		}
+		switch {
+		case day.N == 0:
+		case rule.Freq == Daily || rule.Freq == Weekly:
+			return invalid("BYDAY cannot have ordinals in %v rules", rule.Freq)
+		case rule.Freq == Monthly && (day.N > 5 || day.N < -5):
+			return invalid("BYDAY ordinal %v is out of range", day.N)
+		case day.N > 53 || day.N < -53:
+			return invalid("BYDAY ordinal %v is out of range", day.N)
+		}
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq == Weekly {
+		return invalid("BYMONTHDAY cannot be used in WEEKLY rules")
+	}
+	for _, day := range rule.ByMonthDay {
+		if day == 0 || day > 31 || day < -31 {
+			return invalid("BYMONTHDAY %v is out of range", day)
+		}
+	}
+	for _, month := range rule.ByMonth {
+		if month < time.January || month > time.December {
+			return invalid("BYMONTH %v is out of range", int(month))
+		}
+	}
+	for _, pos := range rule.BySetPos {
+		if pos == 0 || pos > 366 || pos < -366 {
+			return invalid("BYSETPOS %v is out of range", pos)
+		}
+	}
+	if r.Start.Nanosecond() != 0 {
+		return ErrFractionalSeconds
+	}
+	return nil
+}
+
+/*
+Next returns the time of the next meeting after the given time.
+
+If the recurrence ends before the given time, meetingtime.ErrNoLaterMeetings will be returned.
+Floating recurrences are evaluated in the Location of the given time.
+*/
+func (r Recurrence) Next(t time.Time) (time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	e := r.evaluate(t.Location())
+	if r.Rule.Count > 0 {
+		var next time.Time
+		e.each(func(o time.Time) bool {
+			next = o
+			return !o.After(t)
+		})
+		if !next.After(t) {
+			return time.Time{}, meetingtime.ErrNoLaterMeetings
+		}
+		return next, nil
+	}
+
+	from := t
+	if from.Before(e.start) {
+		from = e.start.Add(-time.Nanosecond)
+	}
+	limit := dates.Date(from).AddDate(cycleYears*e.interval, 0, 0)
+	for k := e.align(e.key(dates.Date(from))); ; k += e.interval {
+		for _, d := range e.candidates(k) {
+			o := e.occurrence(d)
+			if o.Before(e.start) || !o.After(t) {
+				continue
+			}
+			if !e.until.IsZero() && o.After(e.until) {
+				return time.Time{}, meetingtime.ErrNoLaterMeetings
+			}
+			return o, nil
+		}
+		if start := e.periodStart(k); start.After(limit) || (!e.until.IsZero() && start.After(dates.Date(e.until))) {
+			return time.Time{}, meetingtime.ErrNoLaterMeetings
+		}
+	}
+}
+
+/*
+Previous returns the time of the closest meeting before the given time.
+
+If the given time is before the first meeting, meetingtime.ErrNoEarlierMeetings will be returned.
+Floating recurrences are evaluated in the Location of the given time.
+*/
+func (r Recurrence) Previous(t time.Time) (time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	e := r.evaluate(t.Location())
+	if !t.After(e.start) {
+		return time.Time{}, meetingtime.ErrNoEarlierMeetings
+	}
+	if r.Rule.Count > 0 {
+		var previous time.Time
+		e.each(func(o time.Time) bool {
+			if !o.Before(t) {
+				return false
+			}
+			previous = o
+			return true
+		})
+		if previous.IsZero() {
+			return time.Time{}, meetingtime.ErrNoEarlierMeetings
+		}
+		return previous, nil
+	}
+
+	to := t
+	if !e.until.IsZero() && to.After(e.until) {
+		to = e.until.Add(time.Nanosecond)
+	}
+	for k := e.align(e.key(dates.Date(to))); k >= e.first; k -= e.interval {
+		candidates := e.candidates(k)
+		for i := len(candidates) - 1; i >= 0; i-- {
+			o := e.occurrence(candidates[i])
+			if o.Before(to) && !o.Before(e.start) {
+				return o, nil
+			}
+		}
+	}
+	return time.Time{}, meetingtime.ErrNoEarlierMeetings
+}
+
+// cycleYears is the number of years after which the Gregorian calendar repeats. If a rule has no meetings
+// for this many of its intervals, it will never have any more.
+const cycleYears = 400
+
+// evaluation holds the details of a Recurrence needed to find its meetings in a particular time zone.
+type evaluation struct {
+	rule     Rule
+	loc      *time.Location
+	start    time.Time // DTSTART in loc
+	until    time.Time // UNTIL in loc, or zero if not set
+	interval int
+	first    int // Key of the period containing DTSTART
+
+	byDay      []Weekday
+	byMonthDay []int
+	byMonth    []time.Month
+}
+
+// evaluate prepares to find the meetings of the Recurrence. Floating recurrences are evaluated in viewer.
+func (r Recurrence) evaluate(viewer *time.Location) *evaluation {
+	e := &evaluation{
+		rule:       r.Rule,
+		loc:        r.Start.Location(),
+		interval:   int(r.Rule.Interval),
+		byDay:      r.Rule.ByDay,
+		byMonthDay: r.Rule.ByMonthDay,
+		byMonth:    r.Rule.ByMonth,
+	}
+	if r.Floating {
+		e.loc = viewer
+	}
+	if e.interval == 0 {
+		e.interval = 1
+	}
+	e.start = localTime(dates.Date(r.Start), r.Start, e.loc)
+	e.until = r.Rule.Until
+	if r.Floating && !e.until.IsZero() {
+		u := e.until
+		e.until = time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), u.Nanosecond(), e.loc)
+	}
+	e.first = e.key(dates.Date(r.Start))
+
+	// Without any days given, meetings are held on the same day as DTSTART
+	if len(e.byDay) == 0 && len(e.byMonthDay) == 0 {
+		switch r.Rule.Freq {
+		case Weekly:
+			e.byDay = []Weekday{{Day: r.Start.Weekday()}}
+		case Monthly:
+			e.byMonthDay = []int{r.Start.Day()}
+		case Yearly:
+			if len(e.byMonth) == 0 {
+				e.byMonth = []time.Month{r.Start.Month()}
+			}
+			e.byMonthDay = []int{r.Start.Day()}
+		}
+	}
+	return e
+}
+
+// each calls fn with each meeting in turn, until fn returns false or there are no more meetings.
+func (e *evaluation) each(fn func(time.Time) bool) {
+	var count uint
+	last := dates.Date(e.start)
+	for k := e.first; ; k += e.interval {
+		for _, d := range e.candidates(k) {
+			o := e.occurrence(d)
+			if o.Before(e.start) {
+				continue
+			}
+			if (!e.until.IsZero() && o.After(e.until)) || (e.rule.Count > 0 && count == e.rule.Count) {
+				return
+			}
+			count++
+			last = d
+			if !fn(o) {
+				return
+			}
+		}
+		if e.periodStart(k).After(last.AddDate(cycleYears*e.interval, 0, 0)) {
+			return
+		}
+	}
+}
+
+// key returns the number of the period containing the date d. Periods are numbered from the Unix epoch for
+// daily and weekly rules, and from year zero for monthly and yearly rules.
+func (e *evaluation) key(d time.Time) int {
+	switch e.rule.Freq {
+	case Weekly:
+		// Weeks start on Monday, and the epoch was on a Thursday
+		return dates.FloorDiv(dates.DayNumber(d)+3, 7)
+	case Monthly:
+		return d.Year()*12 + int(d.Month()) - 1
+	case Yearly:
+		return d.Year()
+	}
+	return dates.DayNumber(d)
+}
+
+// align returns the key of the last period with meetings at or before the period with key k.
+func (e *evaluation) align(k int) int {
+	return e.first + dates.FloorDiv(k-e.first, e.interval)*e.interval
+}
+
+// periodStart returns the first date in the period with key k.
+func (e *evaluation) periodStart(k int) time.Time {
+	switch e.rule.Freq {
+	case Weekly:
+		return fromDayNumber(7*k - 3)
+	case Monthly:
+		return time.Date(dates.FloorDiv(k, 12), time.Month(dates.Mod(k, 12)+1), 1, 0, 0, 0, 0, time.UTC)
+	case Yearly:
+		return time.Date(k, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return fromDayNumber(k)
+}
+
+// candidates returns the dates of meetings in the period with key k, in order.
+func (e *evaluation) candidates(k int) []time.Time {
+	start := e.periodStart(k)
+	var end time.Time
+	switch e.rule.Freq {
+	case Daily:
+		end = start.AddDate(0, 0, 1)
+	case Weekly:
+		end = start.AddDate(0, 0, 7)
+	case Monthly:
+		end = start.AddDate(0, 1, 0)
+	case Yearly:
+		end = start.AddDate(1, 0, 0)
+	}
+	var dates []time.Time
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if e.matches(d) {
+			dates = append(dates, d)
+		}
+	}
+	if len(e.rule.BySetPos) == 0 {
+		return dates
+	}
+
+	var positions []int
+	for _, pos := range e.rule.BySetPos {
+		if pos < 0 {
+			pos += len(dates) + 1
+		}
+		if pos >= 1 && pos <= len(dates) {
+			positions = append(positions, pos-1)
+		}
+	}
+	sort.Ints(positions)
+	var selected []time.Time
+	for i, pos := range positions {
+		if i == 0 || pos != positions[i-1] {
+			selected = append(selected, dates[pos])
+		}
+	}
+	return selected
+}
+
+// matches returns true if the date d meets the requirements of each BYxxx part of the rule.
+func (e *evaluation) matches(d time.Time) bool {
+	if len(e.byMonth) > 0 && !containsMonth(e.byMonth, d.Month()) {
+		return false
+	}
+	monthDays := dates.DaysIn(d.Year(), d.Month())
+	if len(e.byMonthDay) > 0 {
+		var ok bool
+		for _, day := range e.byMonthDay {
+			ok = ok || day == d.Day() || day == d.Day()-monthDays-1
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(e.byDay) > 0 {
+		// Ordinals count weekdays within the month for monthly rules, and yearly rules limited to certain months
+		n, last := (d.Day()-1)/7+1, -((monthDays-d.Day())/7 + 1)
+		if e.rule.Freq == Yearly && len(e.rule.ByMonth) == 0 {
+			yearDays := time.Date(d.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+			n, last = (d.YearDay()-1)/7+1, -((yearDays-d.YearDay())/7 + 1)
+		}
+		var ok bool
+		for _, day := range e.byDay {
+			ok = ok || (day.Day == d.Weekday() && (day.N == 0 || day.N == n || day.N == last))
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// occurrence returns the time of the meeting on the date d.
+func (e *evaluation) occurrence(d time.Time) time.Time {
+	return localTime(d, e.start, e.loc)
+}
+
+// localTime returns the time in loc with the date of d and the clock time of clock.
+// Clock times skipped by daylight saving transitions are interpreted using the UTC offset from
+// before the transition, as required by RFC 5545, moving them later.
+func localTime(d, clock time.Time, loc *time.Location) time.Time {
+	t := time.Date(d.Year(), d.Month(), d.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+	if t.Hour() != clock.Hour() || t.Minute() != clock.Minute() || t.Second() != clock.Second() {
+		// time.Date moved the clock time earlier, using the offset from after the transition
+		_, before := t.Zone()
+		_, after := dates.NextTransition(t).Zone()
+		t = t.Add(time.Duration(after-before) * time.Second)
+	}
+	return t
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func fromDayNumber(n int) time.Time {
+	return time.Unix(int64(n)*24*60*60, 0).UTC()
+}
@@ -0,0 +1,222 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+// The examples from RFC 5545, section 3.8.5.3, all at 9:00AM in New York
+func TestRecurrenceNext(t *testing.T) {
+	var tests = []struct {
+		name     string
+		start    string
+		rule     string
+		expected []string
+		more     bool // The recurrence continues beyond the expected dates
+	}{
+		{
+			name:     "Daily for 10 occurrences",
+			start:    "19970902",
+			rule:     "FREQ=DAILY;COUNT=10",
+			expected: []string{"1997-09-02", "1997-09-03", "1997-09-04", "1997-09-05", "1997-09-06", "1997-09-07", "1997-09-08", "1997-09-09", "1997-09-10", "1997-09-11"},
+		},
+		{
+			name:     "Daily until",
+			start:    "19971220",
+			rule:     "FREQ=DAILY;UNTIL=19971224T000000Z",
+			expected: []string{"1997-12-20", "1997-12-21", "1997-12-22", "1997-12-23"},
+		},
+		{
+			name:     "Every 10 days, 5 occurrences",
+			start:    "19970902",
+			rule:     "FREQ=DAILY;INTERVAL=10;COUNT=5",
+			expected: []string{"1997-09-02", "1997-09-12", "1997-09-22", "1997-10-02", "1997-10-12"},
+		},
+		{
+			name:     "Weekly for 10 occurrences",
+			start:    "19970902",
+			rule:     "FREQ=WEEKLY;COUNT=10",
+			expected: []string{"1997-09-02", "1997-09-09", "1997-09-16", "1997-09-23", "1997-09-30", "1997-10-07", "1997-10-14", "1997-10-21", "1997-10-28", "1997-11-04"},
+		},
+		{
+			name:  "Every other week on Monday, Wednesday and Friday",
+			start: "19970901",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;WKST=SU;BYDAY=MO,WE,FR",
+			expected: []string{
+				"1997-09-01", "1997-09-03", "1997-09-05", "1997-09-15", "1997-09-17", "1997-09-19", "1997-09-29",
+				"1997-10-01", "1997-10-03", "1997-10-13", "1997-10-15", "1997-10-17", "1997-10-27", "1997-10-29", "1997-10-31",
+				"1997-11-10", "1997-11-12", "1997-11-14", "1997-11-24", "1997-11-26", "1997-11-28",
+				"1997-12-08", "1997-12-10", "1997-12-12", "1997-12-22",
+			},
+		},
+		{
+			name:     "Week starting on Monday",
+			start:    "19970805",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			expected: []string{"1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"},
+		},
+		{
+			name:     "First Friday",
+			start:    "19970905",
+			rule:     "FREQ=MONTHLY;COUNT=10;BYDAY=1FR",
+			expected: []string{"1997-09-05", "1997-10-03", "1997-11-07", "1997-12-05", "1998-01-02", "1998-02-06", "1998-03-06", "1998-04-03", "1998-05-01", "1998-06-05"},
+		},
+		{
+			name:     "Every other month on the first and last Sunday",
+			start:    "19970907",
+			rule:     "FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU",
+			expected: []string{"1997-09-07", "1997-09-28", "1997-11-02", "1997-11-30", "1998-01-04", "1998-01-25", "1998-03-01", "1998-03-29", "1998-05-03", "1998-05-31"},
+		},
+		{
+			name:     "Third to last day of the month",
+			start:    "19970928",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-3",
+			expected: []string{"1997-09-28", "1997-10-29", "1997-11-28", "1997-12-29", "1998-01-29", "1998-02-26"},
+			more:     true,
+		},
+		{
+			name:     "15th and 30th, skipping February 30th",
+			start:    "20070115",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5",
+			expected: []string{"2007-01-15", "2007-01-30", "2007-02-15", "2007-03-15", "2007-03-30"},
+		},
+		{
+			name:     "Last work day of the month",
+			start:    "19970929",
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			expected: []string{"1997-09-30", "1997-10-31", "1997-11-28", "1997-12-31", "1998-01-30", "1998-02-27", "1998-03-31"},
+			more:     true,
+		},
+		{
+			name:     "Third Tuesday, Wednesday or Thursday",
+			start:    "19970904",
+			rule:     "FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			expected: []string{"1997-09-04", "1997-10-07", "1997-11-06"},
+		},
+		{
+			name:     "Friday the 13th",
+			start:    "19970902",
+			rule:     "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			expected: []string{"1998-02-13", "1998-03-13", "1998-11-13", "1999-08-13", "2000-10-13"},
+			more:     true,
+		},
+		{
+			name:     "June and July",
+			start:    "19970610",
+			rule:     "FREQ=YEARLY;COUNT=10;BYMONTH=6,7",
+			expected: []string{"1997-06-10", "1997-07-10", "1998-06-10", "1998-07-10", "1999-06-10", "1999-07-10", "2000-06-10", "2000-07-10", "2001-06-10", "2001-07-10"},
+		},
+		{
+			name:     "20th Monday of the year",
+			start:    "19970519",
+			rule:     "FREQ=YEARLY;BYDAY=20MO",
+			expected: []string{"1997-05-19", "1998-05-18", "1999-05-17"},
+			more:     true,
+		},
+		{
+			name:     "Election day",
+			start:    "19961105",
+			rule:     "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8",
+			expected: []string{"1996-11-05", "2000-11-07", "2004-11-02"},
+			more:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse("DTSTART;TZID=America/New_York:" + test.start + "T090000\nRRULE:" + test.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []time.Time
+			next := r.Start.Add(-time.Hour)
+			for i := 0; i < len(test.expected)+1; i++ {
+				next, err = r.Next(next)
+				if err == meetingtime.ErrNoLaterMeetings {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, next)
+			}
+			if test.more && len(got) == len(test.expected)+1 {
+				got = got[:len(test.expected)]
+			}
+			if len(got) != len(test.expected) {
+				t.Fatalf("expected %d meetings, got %v", len(test.expected), got)
+			}
+			for i, expected := range test.expected {
+				if got[i].Format("2006-01-02") != expected || got[i].Hour() != 9 {
+					t.Errorf("%d: expected %v at 9:00AM, got %v", i, expected, got[i])
+				}
+			}
+
+			// Previous should find the same meetings in reverse
+			previous := got[len(got)-1].Add(time.Minute)
+			for i := len(got) - 1; i >= 0; i-- {
+				previous, err = r.Previous(previous)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !previous.Equal(got[i]) {
+					t.Errorf("previous %d: expected %v, got %v", i, got[i], previous)
+				}
+			}
+			if _, err := r.Previous(previous); err != meetingtime.ErrNoEarlierMeetings {
+				t.Errorf("expected '%v' got '%v'", meetingtime.ErrNoEarlierMeetings, err)
+			}
+		})
+	}
+}
+
+func TestRecurrenceDST(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	r, err := Parse("DTSTART;TZID=America/New_York:20160312T023000\nRRULE:FREQ=DAILY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2:30AM is skipped on March 13th, so the meeting is held using the offset from before the gap
+	expected := []time.Time{
+		time.Date(2016, time.March, 12, 2, 30, 0, 0, newYork),
+		time.Date(2016, time.March, 13, 7, 30, 0, 0, time.UTC),
+		time.Date(2016, time.March, 14, 2, 30, 0, 0, newYork),
+	}
+	next := r.Start.Add(-time.Hour)
+	for i, e := range expected {
+		next, err = r.Next(next)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !next.Equal(e) {
+			t.Errorf("%d: expected %v got %v", i, e, next)
+		}
+	}
+}
+
+func TestRecurrenceFloating(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	r, err := Parse("DTSTART:20160104T090000\nRRULE:FREQ=WEEKLY;UNTIL=20160201")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := r.Next(time.Date(2016, time.January, 10, 0, 0, 0, 0, tokyo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2016, time.January, 11, 9, 0, 0, 0, tokyo); !next.Equal(expected) {
+		t.Errorf("expected %v got %v", expected, next)
+	}
+	// UNTIL is a date, so includes meetings on February 1st
+	last, err := r.Previous(time.Date(2017, time.January, 1, 0, 0, 0, 0, tokyo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2016, time.February, 1, 9, 0, 0, 0, tokyo); !last.Equal(expected) {
+		t.Errorf("expected %v got %v", expected, last)
+	}
+	if _, err := r.Next(last); err != meetingtime.ErrNoLaterMeetings {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrNoLaterMeetings, err)
+	}
+}
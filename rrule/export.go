@@ -0,0 +1,163 @@
+package rrule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+/*
+FromSchedule converts a Schedule into a Recurrence with the same meetings.
+
+Some Schedules cannot be expressed as a recurrence rule, and an error will be returned explaining why.
+These include unbounded schedules, monthly schedules on days that some months do not have, yearly
+schedules on February 29th, and schedules whose DSTPolicy affects their meetings in a way that
+differs from recurrence rules.
+*/
+func FromSchedule(schedule meetingtime.Schedule) (Recurrence, error) {
+	if err := schedule.Validate(); err != nil {
+		return Recurrence{}, err
+	}
+	if schedule.Unbounded && schedule.Start.IsZero() {
+		return Recurrence{}, ErrUnbounded
+	}
+
+	// Check the anchor, rather than the first meeting, as the pattern of dates is based on it
+	anchor := schedule.First
+	if !schedule.Floating {
+		anchor = anchor.In(location(schedule))
+	}
+	if anchor.Nanosecond() != 0 {
+		return Recurrence{}, ErrFractionalSeconds
+	}
+
+	rule := Rule{Interval: schedule.Frequency}
+	switch schedule.Type {
+	case meetingtime.Daily:
+		rule.Freq = Daily
+	case meetingtime.Weekly:
+		rule.Freq = Weekly
+	case meetingtime.Monthly:
+		rule.Freq = Monthly
+		if hasShortMonths(anchor, schedule.Frequency) {
+			return Recurrence{}, ErrShortMonth
+		}
+	case meetingtime.MonthlyByWeekday:
+		weekday, n := meetingtime.GetWeekdayAndIndex(anchor)
+		rule.Freq = Monthly
+		rule.Interval = 1
+		rule.ByDay = []Weekday{{N: n, Day: weekday}}
+	case meetingtime.Yearly:
+		rule.Freq = Yearly
+		if anchor.Month() == time.February && anchor.Day() == 29 {
+			return Recurrence{}, ErrShortMonth
+		}
+	}
+
+	start, err := schedule.OccurrenceAt(0)
+	if err == meetingtime.ErrSkippedMeeting {
+		return Recurrence{}, ErrDSTPolicy
+	}
+	if err != nil {
+		return Recurrence{}, err
+	}
+	if schedule.Floating {
+		start = time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+	} else {
+		start = start.In(location(schedule))
+		if err := checkZone(schedule, start); err != nil {
+			return Recurrence{}, err
+		}
+	}
+	if start.Hour() != anchor.Hour() || start.Minute() != anchor.Minute() || start.Second() != anchor.Second() {
+		return Recurrence{}, ErrShiftedStart
+	}
+
+	return Recurrence{
+		Start:    start,
+		Floating: schedule.Floating,
+		Rule:     rule,
+	}, nil
+}
+
+// FromScheduleSlice converts each Schedule in a ScheduleSlice into a Recurrence, as with FromSchedule.
+// If any Schedule cannot be converted, the error will identify its index in the slice.
+func FromScheduleSlice(schedules meetingtime.ScheduleSlice) ([]Recurrence, error) {
+	if len(schedules) == 0 {
+		return nil, meetingtime.ErrEmptySchedule
+	}
+	recurrences := make([]Recurrence, len(schedules))
+	for i, schedule := range schedules {
+		r, err := FromSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %d: %w", i, err)
+		}
+		recurrences[i] = r
+	}
+	return recurrences, nil
+}
+
+// location returns the time zone in which the meetings of a Schedule are held.
+func location(schedule meetingtime.Schedule) *time.Location {
+	if schedule.Location != nil {
+		return schedule.Location
+	}
+	return schedule.First.Location()
+}
+
+// hasShortMonths returns true if a monthly schedule anchored at first, repeating every frequency months,
+// includes a month too short to contain the day of first.
+func hasShortMonths(first time.Time, frequency uint) bool {
+	if first.Day() <= 28 {
+		return false
+	}
+	// The months of the year visited repeat within 12 meetings
+	for k := 0; k < 12; k++ {
+		month := time.Month((int(first.Month())-1+k*int(frequency))%12 + 1)
+		if shortestMonth(month) < first.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+func shortestMonth(month time.Month) int {
+	switch month {
+	case time.February:
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
+
+// checkZone checks that the time zone of a Schedule starting at start can be given as a TZID, and that any
+// meetings affected by daylight saving transitions will be treated the same way by a recurrence rule.
+func checkZone(schedule meetingtime.Schedule, start time.Time) error {
+	transition := dates.NextTransition(start)
+	if transition.IsZero() {
+		// Without any transitions, the zone can be given as UTC
+		return nil
+	}
+	if dates.ZoneName(start.Location()) == "" {
+		return ErrUnnamedZone
+	}
+	if schedule.Gap == meetingtime.DSTShiftForward && schedule.Overlap == meetingtime.DSTShiftBack {
+		return nil
+	}
+
+	// Compare the meetings around each transition with those under the recurrence rule policies
+	standard := schedule
+	standard.Gap = meetingtime.DSTShiftForward
+	standard.Overlap = meetingtime.DSTShiftBack
+	same, err := dates.SameAtTransitions(start, schedule.Next, standard.Next)
+	if err != nil {
+		return err
+	}
+	if !same {
+		return ErrDSTPolicy
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestFromSchedule(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	newYork := mustLoadLocation(t, "America/New_York")
+	var tests = []struct {
+		name        string
+		schedule    meetingtime.Schedule
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "Daily",
+			schedule: meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			expected: "DTSTART:20160101T090000Z\nRRULE:FREQ=DAILY",
+		},
+		{
+			name:     "Every other week",
+			schedule: meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 2),
+			expected: "DTSTART;TZID=Europe/London:20160104T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2",
+		},
+		{
+			name:     "Every 6 months",
+			schedule: meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 6, 9, 0, 0, 0, time.UTC), 6),
+			expected: "DTSTART:20160106T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=6",
+		},
+		{
+			name:     "Yearly on the 31st",
+			schedule: meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 12),
+			expected: "DTSTART:20160131T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=12",
+		},
+		{
+			name:     "2nd Wednesday",
+			schedule: meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 12, 19, 0, 0, 0, newYork)),
+			expected: "DTSTART;TZID=America/New_York:20161012T190000\nRRULE:FREQ=MONTHLY;BYDAY=2WE",
+		},
+		{
+			name:     "5th Monday",
+			schedule: meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.October, 31, 19, 0, 0, 0, time.UTC)),
+			expected: "DTSTART:20161031T190000Z\nRRULE:FREQ=MONTHLY;BYDAY=5MO",
+		},
+		{
+			name:     "Every 2 years",
+			schedule: meetingtime.NewYearlySchedule(time.Date(2016, time.January, 8, 9, 0, 0, 0, time.UTC), 2),
+			expected: "DTSTART:20160108T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=2",
+		},
+		{
+			name: "Floating",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Daily,
+				First:     time.Date(2016, time.January, 1, 9, 0, 0, 0, newYork),
+				Frequency: 1,
+				Floating:  true,
+			},
+			expected: "DTSTART:20160101T090000\nRRULE:FREQ=DAILY",
+		},
+		{
+			name:     "Fixed zone",
+			schedule: meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)), 1),
+			expected: "DTSTART:20160101T000000Z\nRRULE:FREQ=DAILY",
+		},
+		{
+			name: "Series start",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Weekly,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, london),
+				Frequency: 2,
+				Start:     time.Date(2016, time.June, 1, 0, 0, 0, 0, london),
+			},
+			expected: "DTSTART;TZID=Europe/London:20160606T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2",
+		},
+		{
+			name:     "Unaffected by transitions",
+			schedule: meetingtime.NewWeeklySchedule(time.Date(2016, time.January, 6, 2, 30, 0, 0, newYork), 1),
+			expected: "DTSTART;TZID=America/New_York:20160106T023000\nRRULE:FREQ=WEEKLY",
+		},
+		{
+			name: "Matching DST policy",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Daily,
+				First:     time.Date(2016, time.January, 1, 2, 30, 0, 0, newYork),
+				Frequency: 1,
+				Gap:       meetingtime.DSTShiftForward,
+			},
+			expected: "DTSTART;TZID=America/New_York:20160101T023000\nRRULE:FREQ=DAILY",
+		},
+		{
+			name:        "Different DST policy",
+			schedule:    meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 2, 30, 0, 0, newYork), 1),
+			expectedErr: ErrDSTPolicy,
+		},
+		{
+			name: "Unbounded",
+			schedule: meetingtime.Schedule{
+				Type:      meetingtime.Daily,
+				First:     time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Unbounded: true,
+			},
+			expectedErr: ErrUnbounded,
+		},
+		{
+			name:        "Monthly on the 31st",
+			schedule:    meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1),
+			expectedErr: ErrShortMonth,
+		},
+		{
+			name:        "Quarterly on the 30th",
+			schedule:    meetingtime.NewMonthlySchedule(time.Date(2016, time.May, 30, 9, 0, 0, 0, time.UTC), 3),
+			expectedErr: ErrShortMonth,
+		},
+		{
+			name:        "Leap day",
+			schedule:    meetingtime.NewYearlySchedule(time.Date(2016, time.February, 29, 9, 0, 0, 0, time.UTC), 4),
+			expectedErr: ErrShortMonth,
+		},
+		{
+			name:        "Fractional seconds",
+			schedule:    meetingtime.NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 500, time.UTC), 1),
+			expectedErr: ErrFractionalSeconds,
+		},
+		{
+			name:        "Invalid",
+			schedule:    meetingtime.Schedule{Type: meetingtime.Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC)},
+			expectedErr: meetingtime.ErrZeroFrequency,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := FromSchedule(test.schedule)
+			if err != test.expectedErr {
+				t.Fatalf("expected error '%v' got '%v'", test.expectedErr, err)
+			}
+			if err == nil && r.String() != test.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.expected, r)
+			}
+		})
+	}
+}
+
+func TestFromScheduleSlice(t *testing.T) {
+	schedules := meetingtime.ScheduleSlice{
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		meetingtime.NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	recurrences, err := FromScheduleSlice(schedules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"DTSTART:20160905T190000Z\nRRULE:FREQ=MONTHLY;BYDAY=1MO",
+		"DTSTART:20160919T190000Z\nRRULE:FREQ=MONTHLY;BYDAY=3MO",
+	}
+	if len(recurrences) != len(expected) {
+		t.Fatalf("expected %d recurrences, got %d", len(expected), len(recurrences))
+	}
+	for i, r := range recurrences {
+		if r.String() != expected[i] {
+			t.Errorf("%d: expected:\n%s\ngot:\n%s", i, expected[i], r)
+		}
+	}
+
+	schedules = append(schedules, meetingtime.NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1))
+	_, err = FromScheduleSlice(schedules)
+	if !errors.Is(err, ErrShortMonth) || err.Error() != "schedule 2: "+ErrShortMonth.Error() {
+		t.Errorf("expected error for schedule 2, got '%v'", err)
+	}
+	if _, err := FromScheduleSlice(nil); err != meetingtime.ErrEmptySchedule {
+		t.Errorf("expected '%v' got '%v'", meetingtime.ErrEmptySchedule, err)
+	}
+}
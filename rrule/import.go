@@ -0,0 +1,167 @@
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+/*
+Schedule converts the Recurrence into a single Schedule with the same meetings.
+
+If the Recurrence needs several Schedules, such as a weekly rule on more than one day, ErrMultipleSchedules
+will be returned, and ScheduleSlice should be used instead.
+*/
+func (r Recurrence) Schedule() (meetingtime.Schedule, error) {
+	schedules, err := r.ScheduleSlice()
+	if err != nil {
+		return meetingtime.Schedule{}, err
+	}
+	if len(schedules) > 1 {
+		return meetingtime.Schedule{}, ErrMultipleSchedules
+	}
+	return schedules[0], nil
+}
+
+/*
+ScheduleSlice converts the Recurrence into a ScheduleSlice with the same meetings, with a Schedule
+for each day of the week, month or year on which the rule has meetings. For example, a monthly rule
+with BYDAY=1MO,3MO becomes two monthly schedules by weekday.
+
+Rules with an end, given by COUNT or UNTIL, or that select meetings in ways Schedules cannot, such
+as BYSETPOS or the last Friday of the month, return an *UnsupportedError. Such Recurrences can be
+evaluated directly with Next and Previous.
+*/
+func (r Recurrence) ScheduleSlice() (meetingtime.ScheduleSlice, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	rule := r.Rule
+	switch {
+	case rule.Count > 0:
+		return nil, unsupported("COUNT", "schedules do not end after a number of meetings")
+	case !rule.Until.IsZero():
+		return nil, unsupported("UNTIL", "schedules do not end")
+	case len(rule.BySetPos) > 0:
+		return nil, unsupported("BYSETPOS", "schedules cannot select meetings by position")
+	}
+	members, err := r.members()
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules meetingtime.ScheduleSlice
+	for _, m := range members {
+		sub := Recurrence{Start: r.Start, Floating: r.Floating, Rule: m.rule}
+		start := sub.evaluate(r.Start.Location()).start
+		first, err := sub.Next(start.Add(-time.Nanosecond))
+		if err == meetingtime.ErrNoLaterMeetings {
+			// Dates such as April 31st never occur
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first.Hour() != r.Start.Hour() || first.Minute() != r.Start.Minute() || first.Second() != r.Start.Second() {
+			return nil, unsupported("DTSTART", "the first meeting is moved by a daylight saving transition")
+		}
+		if m.schedule == meetingtime.Monthly && hasShortMonths(first, m.frequency) {
+			return nil, unsupported(fmt.Sprintf("BYMONTHDAY=%d", first.Day()), "schedules hold meetings on days missing from shorter months on the last day of the month")
+		}
+		schedules = append(schedules, meetingtime.Schedule{
+			Type:      m.schedule,
+			First:     first,
+			Frequency: m.frequency,
+			Gap:       meetingtime.DSTShiftForward,
+			Overlap:   meetingtime.DSTShiftBack,
+			Floating:  r.Floating,
+		})
+	}
+	if len(schedules) == 0 {
+		return nil, invalid("recurrence has no meetings")
+	}
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return schedules[i].First.Before(schedules[j].First)
+	})
+	return schedules, nil
+}
+
+// member describes a Schedule needed to express a Recurrence, and a rule for its meetings alone.
+type member struct {
+	schedule  meetingtime.ScheduleType
+	frequency uint
+	rule      Rule
+}
+
+// members returns the Schedules needed to express the Recurrence.
+func (r Recurrence) members() ([]member, error) {
+	rule := r.Rule
+	e := r.evaluate(r.Start.Location())
+	interval := uint(e.interval)
+
+	if len(rule.ByMonth) > 0 && rule.Freq != Yearly {
+		return nil, unsupported("BYMONTH", fmt.Sprintf("%s schedules cannot be limited to certain months", strings.ToLower(rule.Freq.String())))
+	}
+
+	var members []member
+	switch rule.Freq {
+	case Daily:
+		if len(rule.ByMonthDay) > 0 {
+			return nil, unsupported("BYMONTHDAY", "daily schedules cannot be limited to certain days of the month")
+		}
+		if len(rule.ByDay) == 0 {
+			return []member{{meetingtime.Daily, interval, Rule{Freq: Daily, Interval: interval}}}, nil
+		}
+		if interval > 1 {
+			return nil, unsupported("INTERVAL", "daily schedules cannot be limited to certain days of the week")
+		}
+		for _, day := range rule.ByDay {
+			members = append(members, member{meetingtime.Weekly, 1, Rule{Freq: Weekly, ByDay: []Weekday{day}}})
+		}
+	case Weekly:
+		for _, day := range e.byDay {
+			members = append(members, member{meetingtime.Weekly, interval, Rule{Freq: Weekly, Interval: interval, ByDay: []Weekday{day}}})
+		}
+	case Monthly:
+		if len(rule.ByDay) > 0 && len(rule.ByMonthDay) > 0 {
+			return nil, unsupported("BYMONTHDAY", "schedules cannot combine days of the month with days of the week")
+		}
+		for _, day := range rule.ByDay {
+			switch {
+			case day.N < 0:
+				return nil, unsupported("BYDAY="+day.String(), "schedules count weekdays from the start of the month")
+			case interval > 1:
+				return nil, unsupported("INTERVAL", "schedules by day of the week repeat every month")
+			case day.N == 0:
+				members = append(members, member{meetingtime.Weekly, 1, Rule{Freq: Weekly, ByDay: []Weekday{day}}})
+			default:
+				members = append(members, member{meetingtime.MonthlyByWeekday, 1, Rule{Freq: Monthly, ByDay: []Weekday{day}}})
+			}
+		}
+		for _, day := range e.byMonthDay {
+			if day < 0 {
+				return nil, unsupported(fmt.Sprintf("BYMONTHDAY=%d", day), "schedules count days from the start of the month")
+			}
+			members = append(members, member{meetingtime.Monthly, interval, Rule{Freq: Monthly, Interval: interval, ByMonthDay: []int{day}}})
+		}
+	case Yearly:
+		if len(rule.ByDay) > 0 {
+			return nil, unsupported("BYDAY", "yearly schedules cannot be limited to days of the week")
+		}
+		for _, month := range e.byMonth {
+			for _, day := range e.byMonthDay {
+				if day < 0 {
+					return nil, unsupported(fmt.Sprintf("BYMONTHDAY=%d", day), "schedules count days from the start of the month")
+				}
+				if month == time.February && day == 29 {
+					return nil, unsupported("BYMONTHDAY=29", "yearly schedules hold meetings on February 29th on February 28th in other years")
+				}
+				members = append(members, member{meetingtime.Yearly, interval, Rule{Freq: Yearly, Interval: interval, ByMonth: []time.Month{month}, ByMonthDay: []int{day}}})
+			}
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,152 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime"
+)
+
+func TestScheduleSlice(t *testing.T) {
+	var tests = []struct {
+		name     string
+		in       string
+		expected string
+	}{
+		{
+			name:     "Every other week",
+			in:       "DTSTART;TZID=Europe/London:20160104T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2",
+			expected: "weekly/2@2016-01-04T09:00[Europe/London];gap=shift-forward",
+		},
+		{
+			name:     "Weekdays",
+			in:       "DTSTART:20160106T090000Z\nRRULE:FREQ=DAILY;BYDAY=MO,WE,FR",
+			expected: "weekly@2016-01-06T09:00[UTC];gap=shift-forward,weekly@2016-01-08T09:00[UTC];gap=shift-forward,weekly@2016-01-11T09:00[UTC];gap=shift-forward",
+		},
+		{
+			name:     "Every other week on two days",
+			in:       "DTSTART:20160106T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			expected: "weekly/2@2016-01-06T09:00;gap=shift-forward,weekly/2@2016-01-18T09:00;gap=shift-forward",
+		},
+		{
+			name:     "First and third Monday",
+			in:       "DTSTART;TZID=America/New_York:20160905T190000\nRRULE:FREQ=MONTHLY;BYDAY=1MO,3MO",
+			expected: "monthly-by-weekday@2016-09-05T19:00[America/New_York];gap=shift-forward,monthly-by-weekday@2016-09-19T19:00[America/New_York];gap=shift-forward",
+		},
+		{
+			name:     "Every 6 months",
+			in:       "DTSTART:20160106T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=6",
+			expected: "monthly/6@2016-01-06T09:00[UTC];gap=shift-forward",
+		},
+		{
+			name:     "Twice monthly",
+			in:       "DTSTART:20160120T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=5,20",
+			expected: "monthly@2016-01-20T09:00[UTC];gap=shift-forward,monthly@2016-02-05T09:00[UTC];gap=shift-forward",
+		},
+		{
+			name:     "Every Monday of the month",
+			in:       "DTSTART:20160104T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=MO",
+			expected: "weekly@2016-01-04T09:00[UTC];gap=shift-forward",
+		},
+		{
+			name:     "Twice yearly",
+			in:       "DTSTART:20160710T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=2;BYMONTH=1,7",
+			expected: "yearly/2@2016-07-10T09:00[UTC];gap=shift-forward,yearly/2@2018-01-10T09:00[UTC];gap=shift-forward",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			schedules, err := r.ScheduleSlice()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if schedules.String() != test.expected {
+				t.Errorf("expected %s got %s", test.expected, schedules)
+			}
+
+			// The schedules should have the same meetings as the recurrence
+			expected, got := r.Start.Add(-time.Hour), r.Start.Add(-time.Hour)
+			for i := 0; i < 100; i++ {
+				if expected, err = r.Next(expected); err != nil {
+					t.Fatal(err)
+				}
+				if got, err = schedules.Next(got); err != nil {
+					t.Fatal(err)
+				}
+				if !got.Equal(expected) {
+					t.Fatalf("%d: expected %v got %v", i, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleSliceUnsupported(t *testing.T) {
+	var tests = []struct {
+		in          string
+		unsupported string
+	}{
+		{in: "FREQ=DAILY;COUNT=10", unsupported: "COUNT"},
+		{in: "FREQ=DAILY;UNTIL=20170101", unsupported: "UNTIL"},
+		{in: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", unsupported: "BYSETPOS"},
+		{in: "FREQ=MONTHLY;BYDAY=-1FR", unsupported: "BYDAY=-1FR"},
+		{in: "FREQ=MONTHLY;INTERVAL=2;BYDAY=1FR", unsupported: "INTERVAL"},
+		{in: "FREQ=MONTHLY;BYMONTHDAY=-1", unsupported: "BYMONTHDAY=-1"},
+		{in: "FREQ=MONTHLY;BYMONTHDAY=31", unsupported: "BYMONTHDAY=31"},
+		{in: "FREQ=MONTHLY;BYMONTH=1,7", unsupported: "BYMONTH"},
+		{in: "FREQ=DAILY;INTERVAL=2;BYDAY=MO", unsupported: "INTERVAL"},
+		{in: "FREQ=YEARLY;BYDAY=20MO", unsupported: "BYDAY"},
+		{in: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29", unsupported: "BYMONTHDAY=29"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			r, err := Parse("DTSTART:20160104T090000Z\nRRULE:" + test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = r.ScheduleSlice()
+			var unsupported *UnsupportedError
+			if !errors.As(err, &unsupported) || unsupported.Part != test.unsupported {
+				t.Errorf("expected %s to be unsupported, got '%v'", test.unsupported, err)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	in := meetingtime.Schedule{
+		Type:      meetingtime.MonthlyByWeekday,
+		First:     time.Date(2016, time.October, 12, 19, 0, 0, 0, london),
+		Frequency: 1,
+		Gap:       meetingtime.DSTShiftForward,
+	}
+	exported, err := FromSchedule(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := Parse(exported.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := r.Schedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != in.String() {
+		t.Errorf("expected %v got %v", in, out)
+	}
+
+	r, err = Parse("DTSTART:20160104T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,TU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Schedule(); err != ErrMultipleSchedules {
+		t.Errorf("expected '%v' got '%v'", ErrMultipleSchedules, err)
+	}
+}
@@ -0,0 +1,279 @@
+package rrule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/contentline"
+)
+
+/*
+Parse parses a recurrence given as DTSTART and RRULE properties, such as:
+
+	DTSTART;TZID=Europe/London:20160104T090000
+	RRULE:FREQ=WEEKLY;INTERVAL=2
+
+The properties may be given in either order, on separate lines, which may be folded as described in RFC 5545.
+A DTSTART with a TZID is evaluated in that time zone, and a DTSTART with neither a TZID nor a UTC time is floating.
+
+Recurrences that are valid, but use features this package does not support, such as BYWEEKNO or an all-day
+DTSTART, return an *UnsupportedError identifying the part concerned. Other problems return an error wrapping ErrInvalid.
+*/
+func Parse(text string) (Recurrence, error) {
+	var (
+		startParams       []string
+		start, rule       string
+		hasStart, hasRule bool
+	)
+	for _, line := range contentline.Unfold(text) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		name, params, value, ok := contentline.Split(line)
+		if !ok {
+			return Recurrence{}, invalid("no value in %q", line)
+		}
+		switch name {
+		case "DTSTART":
+			if hasStart {
+				return Recurrence{}, invalid("more than one DTSTART")
+			}
+			startParams, start, hasStart = params, value, true
+		case "RRULE":
+			if hasRule {
+				return Recurrence{}, unsupported("RRULE", "recurrences with more than one rule are not supported")
+			}
+			rule, hasRule = value, true
+		default:
+			return Recurrence{}, unsupported(name, "only DTSTART and RRULE properties are supported")
+		}
+	}
+	if !hasStart {
+		return Recurrence{}, invalid("missing DTSTART")
+	}
+	if !hasRule {
+		return Recurrence{}, invalid("missing RRULE")
+	}
+
+	var (
+		r   Recurrence
+		err error
+	)
+	r.Start, r.Floating, err = parseStart(startParams, start)
+	if err != nil {
+		return Recurrence{}, err
+	}
+	r.Rule, err = parseRule(rule, r.Start)
+	if err != nil {
+		return Recurrence{}, err
+	}
+	return r, r.Validate()
+}
+
+// parseStart parses the parameters and value of a DTSTART property.
+func parseStart(params []string, value string) (t time.Time, floating bool, err error) {
+	loc := time.UTC
+	var zoned bool
+	for _, param := range params {
+		name, value, _ := strings.Cut(param, "=")
+		value = strings.Trim(value, `"`)
+		switch strings.ToUpper(name) {
+		case "TZID":
+			if loc, err = time.LoadLocation(value); err != nil {
+				return time.Time{}, false, invalid("unknown time zone %q", value)
+			}
+			zoned = true
+		case "VALUE":
+			switch strings.ToUpper(value) {
+			case "DATE":
+				return time.Time{}, false, unsupported("VALUE=DATE", "all-day recurrences are not supported")
+			case "DATE-TIME":
+			default:
+				return time.Time{}, false, invalid("DTSTART cannot have VALUE=%s", value)
+			}
+		}
+	}
+	if len(value) == len("20060102") {
+		return time.Time{}, false, unsupported("VALUE=DATE", "all-day recurrences are not supported")
+	}
+	utc := strings.HasSuffix(value, "Z")
+	if utc && zoned {
+		return time.Time{}, false, invalid("DTSTART in UTC cannot have a TZID")
+	}
+	t, err = time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, false, invalid("DTSTART %q is not a date and time", value)
+	}
+	return t, !utc && !zoned, nil
+}
+
+// parseRule parses the value of an RRULE property for a recurrence starting at start.
+// A local time given for UNTIL is interpreted in the time zone of start.
+func parseRule(value string, start time.Time) (Rule, error) {
+	var (
+		rule      Rule
+		weekStart = time.Monday
+		seen      = make(map[string]bool)
+	)
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+		value = strings.ToUpper(value)
+		if !ok || value == "" {
+			return Rule{}, invalid("rule part %q has no value", part)
+		}
+		if seen[name] {
+			return Rule{}, invalid("more than one %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(value)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value, start.Location())
+		case "COUNT":
+			rule.Count, err = parsePositive(name, value)
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(name, value)
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				var day Weekday
+				if day, err = parseWeekday(v); err != nil {
+					break
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseList(name, value, 31, true)
+		case "BYMONTH":
+			var months []int
+			months, err = parseList(name, value, 12, false)
+			for _, month := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseList(name, value, 366, true)
+		case "WKST":
+			var day Weekday
+			day, err = parseWeekday(value)
+			if err == nil && day.N != 0 {
+				err = invalid("WKST %q is not a day of the week", value)
+			}
+			weekStart = day.Day
+		case "BYSECOND", "BYMINUTE", "BYHOUR":
+			err = unsupported(name, "meetings more frequent than daily are not supported")
+		case "BYYEARDAY", "BYWEEKNO":
+			err = unsupported(name, "only days of the week and month are supported")
+		case "RSCALE", "SKIP":
+			err = unsupported(name, "only the Gregorian calendar is supported")
+		default:
+			err = invalid("unknown rule part %q", name)
+		}
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+	if !seen["FREQ"] {
+		return Rule{}, invalid("missing FREQ")
+	}
+	if weekStart != time.Monday && weekStartMatters(rule, weekStart, start.Weekday()) {
+		return Rule{}, unsupported("WKST="+weekdayNames[weekStart], "weeks can only start on Monday")
+	}
+	return rule, nil
+}
+
+// weekStartMatters returns true if starting weeks on weekStart, rather than Monday, would change the meetings
+// of a rule starting on the given day. This is only the case for weekly rules at intervals of more than a week,
+// with days both before and after Monday in a week starting on weekStart.
+func weekStartMatters(rule Rule, weekStart, start time.Weekday) bool {
+	if rule.Freq != Weekly || rule.Interval < 2 {
+		return false
+	}
+	// Days from weekStart up to Monday move to the following week
+	moved := func(day time.Weekday) bool {
+		return (day-weekStart+7)%7 < (time.Monday-weekStart+7)%7
+	}
+	for _, day := range rule.ByDay {
+		if moved(day.Day) != moved(start) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	for freq, name := range frequencyNames {
+		if name == value {
+			return freq, nil
+		}
+	}
+	switch value {
+	case "SECONDLY", "MINUTELY", "HOURLY":
+		return 0, unsupported("FREQ="+value, "meetings more frequent than daily are not supported")
+	}
+	return 0, invalid("unknown FREQ %q", value)
+}
+
+// parseUntil parses the value of UNTIL. A date includes all meetings on that date, and a local time is interpreted in loc.
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, invalid("UNTIL %q is not a date or date and time", value)
+	}
+	return t, nil
+}
+
+func parsePositive(name, value string) (uint, error) {
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || n == 0 {
+		return 0, invalid("%s %q is not a positive number", name, value)
+	}
+	return uint(n), nil
+}
+
+// parseList parses a list of numbers from 1 to max. If negative is true, numbers from -max to -1 are also allowed.
+func parseList(name, value string, max int, negative bool) ([]int, error) {
+	var out []int
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil || n == 0 || n > max || n < -max || (n < 0 && !negative) {
+			return nil, invalid("%s value %q is out of range", name, v)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// parseWeekday parses a day of the week with an optional ordinal, such as "WE" or "-1FR".
+func parseWeekday(value string) (Weekday, error) {
+	if len(value) < 2 {
+		return Weekday{}, invalid("%q is not a day of the week", value)
+	}
+	var w Weekday
+	if prefix := value[:len(value)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 {
+			return Weekday{}, invalid("%q is not a day of the week", value)
+		}
+		w.N = n
+	}
+	for day, name := range weekdayNames {
+		if name == value[len(value)-2:] {
+			w.Day = time.Weekday(day)
+			return w, nil
+		}
+	}
+	return Weekday{}, invalid("%q is not a day of the week", value)
+}
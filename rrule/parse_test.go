@@ -0,0 +1,129 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	var tests = []struct {
+		name     string
+		in       string
+		expected Recurrence
+		out      string
+	}{
+		{
+			name: "Time zone",
+			in:   "DTSTART;TZID=Europe/London:20160104T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2",
+			expected: Recurrence{
+				Start: time.Date(2016, time.January, 4, 9, 0, 0, 0, london),
+				Rule:  Rule{Freq: Weekly, Interval: 2},
+			},
+		},
+		{
+			name: "UTC, folded and in any order",
+			in:   "RRULE:FREQ=MONTHLY;BYDAY=1MO,\r\n -1FR;COUNT=3\r\nDTSTART:20160104T090000Z\r\n",
+			expected: Recurrence{
+				Start: time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Rule:  Rule{Freq: Monthly, Count: 3, ByDay: []Weekday{{N: 1, Day: time.Monday}, {N: -1, Day: time.Friday}}},
+			},
+			out: "DTSTART:20160104T090000Z\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=1MO,-1FR",
+		},
+		{
+			name: "Floating",
+			in:   "DTSTART:20160104T090000\nRRULE:FREQ=YEARLY;UNTIL=20200101T000000;BYMONTH=1,7;BYMONTHDAY=4,-1;BYSETPOS=1",
+			expected: Recurrence{
+				Start:    time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Floating: true,
+				Rule: Rule{
+					Freq:       Yearly,
+					Until:      time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ByMonth:    []time.Month{time.January, time.July},
+					ByMonthDay: []int{4, -1},
+					BySetPos:   []int{1},
+				},
+			},
+			out: "DTSTART:20160104T090000\nRRULE:FREQ=YEARLY;UNTIL=20200101T000000;BYMONTHDAY=4,-1;BYMONTH=1,7;BYSETPOS=1",
+		},
+		{
+			name: "Local UNTIL",
+			in:   "DTSTART;TZID=Europe/London:20160104T090000\nRRULE:FREQ=DAILY;UNTIL=20160701T090000",
+			expected: Recurrence{
+				Start: time.Date(2016, time.January, 4, 9, 0, 0, 0, london),
+				Rule:  Rule{Freq: Daily, Until: time.Date(2016, time.July, 1, 9, 0, 0, 0, london)},
+			},
+			out: "DTSTART;TZID=Europe/London:20160104T090000\nRRULE:FREQ=DAILY;UNTIL=20160701T080000Z",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !r.Start.Equal(test.expected.Start) || r.Start.Location().String() != test.expected.Start.Location().String() || r.Floating != test.expected.Floating {
+				t.Errorf("start: expected %v (floating %v) got %v (floating %v)", test.expected.Start, test.expected.Floating, r.Start, r.Floating)
+			}
+			if r.Rule.String() != test.expected.Rule.String() || !r.Rule.Until.Equal(test.expected.Rule.Until) {
+				t.Errorf("rule: expected %v got %v", test.expected.Rule, r.Rule)
+			}
+			out := test.out
+			if out == "" {
+				out = test.in
+			}
+			if r.String() != out {
+				t.Errorf("String: expected:\n%s\ngot:\n%s", out, r)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	var tests = []struct {
+		name        string
+		in          string
+		unsupported string
+	}{
+		{name: "Missing DTSTART", in: "RRULE:FREQ=DAILY"},
+		{name: "Missing RRULE", in: "DTSTART:20160104T090000Z"},
+		{name: "Missing FREQ", in: "DTSTART:20160104T090000Z\nRRULE:INTERVAL=2"},
+		{name: "Unknown FREQ", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=SOMETIMES"},
+		{name: "Unknown part", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY;SOMETIMES=1"},
+		{name: "Repeated part", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY;FREQ=WEEKLY"},
+		{name: "Zero interval", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY;INTERVAL=0"},
+		{name: "COUNT and UNTIL", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY;COUNT=2;UNTIL=20170101"},
+		{name: "Ordinal in weekly rule", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=1MO"},
+		{name: "Ordinal out of range", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=6MO"},
+		{name: "Day of month in weekly rule", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=WEEKLY;BYMONTHDAY=1"},
+		{name: "Month out of range", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=13"},
+		{name: "Unknown day", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=XX"},
+		{name: "Unknown time zone", in: "DTSTART;TZID=Nowhere/Special:20160104T090000\nRRULE:FREQ=DAILY"},
+		{name: "Malformed DTSTART", in: "DTSTART:2016-01-04\nRRULE:FREQ=DAILY"},
+		{name: "No value", in: "DTSTART\nRRULE:FREQ=DAILY"},
+		{name: "Hourly", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=HOURLY", unsupported: "FREQ=HOURLY"},
+		{name: "By hour", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY;BYHOUR=9,17", unsupported: "BYHOUR"},
+		{name: "By week number", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=YEARLY;BYWEEKNO=20", unsupported: "BYWEEKNO"},
+		{name: "All day", in: "DTSTART;VALUE=DATE:20160104\nRRULE:FREQ=DAILY", unsupported: "VALUE=DATE"},
+		{name: "Other properties", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY\nEXDATE:20160105T090000Z", unsupported: "EXDATE"},
+		{name: "Multiple rules", in: "DTSTART:20160104T090000Z\nRRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY", unsupported: "RRULE"},
+		{
+			name:        "Week starting on Sunday",
+			in:          "DTSTART:19970805T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			unsupported: "WKST=SU",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.in)
+			var unsupported *UnsupportedError
+			switch {
+			case test.unsupported == "" && !errors.Is(err, ErrInvalid):
+				t.Errorf("expected an error wrapping '%v', got '%v'", ErrInvalid, err)
+			case test.unsupported != "" && (!errors.As(err, &unsupported) || unsupported.Part != test.unsupported):
+				t.Errorf("expected %s to be unsupported, got '%v'", test.unsupported, err)
+			}
+		})
+	}
+}
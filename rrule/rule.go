@@ -0,0 +1,158 @@
+package rrule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+// Frequency specifies the FREQ part of a recurrence rule.
+type Frequency uint8
+
+const (
+	// Daily specifies a rule that repeats daily.
+	Daily Frequency = iota
+	// Weekly specifies a rule that repeats weekly.
+	Weekly
+	// Monthly specifies a rule that repeats monthly.
+	Monthly
+	// Yearly specifies a rule that repeats yearly.
+	Yearly
+)
+
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+	Yearly:  "YEARLY",
+}
+
+func (f Frequency) String() string {
+	if name, ok := frequencyNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Frequency(%d)", uint8(f))
+}
+
+var weekdayNames = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// Weekday is a day of the week in the BYDAY part of a recurrence rule. If N is non-zero, it specifies the nth
+// instance of the day within the month or year, counting back from the end if negative, such as the 2 in "2WE".
+type Weekday struct {
+	N   int
+	Day time.Weekday
+}
+
+func (w Weekday) String() string {
+	if w.N != 0 {
+		return fmt.Sprintf("%d%s", w.N, weekdayNames[w.Day])
+	}
+	return weekdayNames[w.Day]
+}
+
+// Rule is a recurrence rule, as given by the RRULE property.
+type Rule struct {
+	Freq       Frequency    // How often the rule repeats
+	Until      time.Time    // Optional time of the last meeting. Meetings after Until are omitted.
+	Count      uint         // Optional number of meetings. Zero means there is no limit.
+	Interval   uint         // Number of periods between repetitions. Zero is treated as 1.
+	ByDay      []Weekday    // Days of the week on which meetings are held
+	ByMonthDay []int        // Days of the month on which meetings are held, counting back from the end if negative
+	ByMonth    []time.Month // Months in which meetings are held
+	BySetPos   []int        // Positions of the meetings to hold among those in each period, counting back from the end if negative
+}
+
+// String returns the value of the RRULE property, such as "FREQ=MONTHLY;BYDAY=2WE".
+func (r Rule) String() string {
+	return r.format(false)
+}
+
+// format returns the value of the RRULE property. If floating is true, Until is given as a floating time.
+func (r Rule) format(floating bool) string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if !r.Until.IsZero() {
+		if floating {
+			parts = append(parts, "UNTIL="+r.Until.Format(dateTimeLayout))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format(dateTimeLayout)+"Z")
+		}
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]int, len(r.ByMonth))
+		for i, month := range r.ByMonth {
+			months[i] = int(month)
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(values []int) string {
+	out := make([]string, len(values))
+	for i, value := range values {
+		out[i] = strconv.Itoa(value)
+	}
+	return strings.Join(out, ",")
+}
+
+// Recurrence is a recurrence rule together with the start of the series it applies to.
+type Recurrence struct {
+	Start    time.Time // Time of the first meeting, as given by the DTSTART property
+	Floating bool      // If true, Start is a floating time with no time zone
+	Rule     Rule
+}
+
+const dateTimeLayout = "20060102T150405"
+
+/*
+String returns the DTSTART and RRULE properties of the Recurrence, on separate lines. For example:
+
+	DTSTART;TZID=Europe/London:20160104T090000
+	RRULE:FREQ=WEEKLY;INTERVAL=2
+
+Start is given with the IANA name of its time zone where possible, otherwise it is converted to UTC.
+*/
+func (r Recurrence) String() string {
+	return "DTSTART" + formatDateTime(r.Start, r.Floating) + "\nRRULE:" + r.Rule.format(r.Floating)
+}
+
+// formatDateTime returns the parameters and value of a DATE-TIME property, such as ";TZID=Europe/London:20160104T090000".
+func formatDateTime(t time.Time, floating bool) string {
+	if floating {
+		return ":" + t.Format(dateTimeLayout)
+	}
+	if name := dates.ZoneName(t.Location()); name != "" && name != "UTC" {
+		return ";TZID=" + name + ":" + t.Format(dateTimeLayout)
+	}
+	return ":" + t.UTC().Format(dateTimeLayout) + "Z"
+}
@@ -1,15 +1,36 @@
 package meetingtime
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
 )
 
-// Schedule defines a regular schedule for a meeting
+/*
+Schedule defines a regular schedule for a meeting
+
+By default, the series of meetings starts with First. First also anchors the pattern of meetings, so
+a series may start later by setting Start, or extend indefinitely into the past by setting Unbounded.
+
+A floating Schedule, like a floating time in iCalendar, has meetings at the same clock time in any
+time zone. Floating schedules are evaluated in the Location of the time passed to Next, Previous and
+other methods, so a daily 9am meeting will be at 9am wherever the viewer is.
+*/
 type Schedule struct {
 	Type      ScheduleType // Type of recurrence
-	First     time.Time    // Time and date of first meeting
+	First     time.Time    // Time and date of first meeting, or of any meeting in the pattern if Start or Unbounded are set
 	Frequency uint         // How frequently this meeting occurs. For a daily meeting, 2 would mean every other day.
+	Start     time.Time    // Optional start of the series, if different from First. The first meeting is the first at or after Start.
+	Unbounded bool         // If true, and Start is not set, the pattern extends before First with no first meeting.
+
+	Location *time.Location // Optional time zone in which meetings are held. If nil, the Location of First is used.
+	Gap      DSTPolicy      // How to handle meetings at clock times skipped by daylight saving transitions.
+	Overlap  DSTPolicy      // How to handle meetings at clock times repeated by daylight saving transitions.
+	Floating bool           // If true, meetings are held at the clock time of First in whichever time zone the Schedule is evaluated.
+
+	viewer *time.Location // Time zone in which a floating Schedule is being evaluated
 }
 
 // ScheduleType specifies the way in which this schedule recurs
@@ -28,17 +49,70 @@ const (
 	Yearly
 )
 
-// NewDailySchedule creates a schedule recurring every n days
+var scheduleTypeNames = map[ScheduleType]string{
+	Daily:            "daily",
+	Weekly:           "weekly",
+	Monthly:          "monthly",
+	MonthlyByWeekday: "monthly-by-weekday",
+	Yearly:           "yearly",
+}
+
+func (t ScheduleType) String() string {
+	if name, ok := scheduleTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("ScheduleType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the type by name, such as "weekly".
+func (t ScheduleType) MarshalText() ([]byte, error) {
+	if name, ok := scheduleTypeNames[t]; ok {
+		return []byte(name), nil
+	}
+	return nil, ErrUnknownScheduleType
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a type name as produced by MarshalText.
+func (t *ScheduleType) UnmarshalText(text []byte) error {
+	for value, name := range scheduleTypeNames {
+		if name == string(text) {
+			*t = value
+			return nil
+		}
+	}
+	return ErrUnknownScheduleType
+}
+
+// NewSchedule creates a schedule of the given type, recurring every n days, weeks, months or years as for the
+// constructor of that type, and validates it. ErrZeroFrequency is returned if n is zero for a type that needs a
+// frequency, and ErrUnknownScheduleType for types not defined in this package.
+func NewSchedule(scheduleType ScheduleType, first time.Time, n uint) (Schedule, error) {
+	s := Schedule{Type: scheduleType, First: first, Frequency: n}
+	if scheduleType == MonthlyByWeekday {
+		s.Frequency = 1
+	}
+	if err := s.Validate(); err != nil {
+		return Schedule{}, err
+	}
+	return s, nil
+}
+
+// NewDailySchedule creates a schedule recurring every n days. Schedules with an n of zero will fail to validate,
+// so NewSchedule should be used where n is not known to be valid.
 func NewDailySchedule(first time.Time, n uint) Schedule {
 	return Schedule{Type: Daily, First: first, Frequency: n}
 }
 
-// NewWeeklySchedule creates a schedule recurring on the same day every n weeks
+// NewWeeklySchedule creates a schedule recurring on the same day every n weeks. As with NewDailySchedule,
+// n should not be zero.
 func NewWeeklySchedule(first time.Time, n uint) Schedule {
 	return Schedule{Type: Weekly, First: first, Frequency: n}
 }
 
 // NewMonthlySchedule creates a schedule recurring on the specified day in the month, every n months.
+// In months too short to contain that day, meetings are held on the last day of the month, so a
+// monthly meeting first held on January 31st will be followed by ones on February 29th and March 31st.
+// As with NewDailySchedule, n should not be zero.
 func NewMonthlySchedule(first time.Time, n uint) Schedule {
 	return Schedule{Type: Monthly, First: first, Frequency: n}
 }
@@ -48,24 +122,66 @@ func NewMonthlyScheduleByWeekday(first time.Time) Schedule {
 	return Schedule{Type: MonthlyByWeekday, First: first, Frequency: 1}
 }
 
-// NewYearlySchedule creates a schedule recurring every n years
+// NewYearlySchedule creates a schedule recurring every n years. As with NewDailySchedule, n should not be zero.
 func NewYearlySchedule(first time.Time, n uint) Schedule {
 	return Schedule{Type: Yearly, First: first, Frequency: n}
 }
 
+// Validate checks that the Schedule has a known Type, and a Frequency that will allow it to
+// progress beyond its first meeting.
+func (s Schedule) Validate() error {
+	switch s.Type {
+	case Daily, Weekly, Monthly, Yearly:
+		if s.Frequency == 0 {
+			return ErrZeroFrequency
+		}
+	case MonthlyByWeekday:
+		// Frequency is ignored
+	default:
+		return ErrUnknownScheduleType
+	}
+	if s.Gap > DSTSkip || s.Overlap > DSTSkip {
+		return ErrUnknownDSTPolicy
+	}
+	if s.Floating && s.Location != nil {
+		return ErrFloatingLocation
+	}
+	return nil
+}
+
 /*
 Next returns the time of the next meeting after the given time.
 */
 func (s Schedule) Next(t time.Time) (time.Time, error) {
-	var err error
-	c := s.First
-	for c.Before(t) || c.Equal(t) {
-		c, err = s.increment(c)
-		if err != nil {
-			return time.Time{}, err
+	return s.NextContext(context.Background(), t)
+}
+
+/*
+NextContext returns the time of the next meeting after the given time.
+
+If ctx is cancelled, or the budget set with WithEvaluationBudget is used up, evaluation will stop
+and the error will be returned.
+*/
+func (s Schedule) NextContext(ctx context.Context, t time.Time) (time.Time, error) {
+	s = s.in(t.Location())
+	k, err := s.floor(ctx, t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	k++
+	base, bounded, err := s.base(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if bounded && k < base {
+		k = base
+	}
+	for ; ; k++ {
+		c, skipped, err := s.slot(ctx, k)
+		if err != nil || !skipped {
+			return c, err
 		}
 	}
-	return c, nil
 }
 
 /*
@@ -74,47 +190,378 @@ Previous returns the time of the closest meeting before the given time.
 If the given time is before the first meeting, ErrNoEarlierMeetings will be returned.
 */
 func (s Schedule) Previous(t time.Time) (time.Time, error) {
-	if t.Before(s.First) || t.Equal(s.First) {
-		return time.Time{}, ErrNoEarlierMeetings
-	}
-	var err error
-	c := s.First
-	prev := s.First
-	for c.Before(t) {
-		prev = c
-		c, err = s.increment(c)
+	return s.PreviousContext(context.Background(), t)
+}
+
+/*
+PreviousContext returns the time of the closest meeting before the given time.
+
+If the given time is before the first meeting, ErrNoEarlierMeetings will be returned.
+If ctx is cancelled, or the budget set with WithEvaluationBudget is used up, evaluation will stop
+and the error will be returned.
+*/
+func (s Schedule) PreviousContext(ctx context.Context, t time.Time) (time.Time, error) {
+	s = s.in(t.Location())
+	k, err := s.floor(ctx, t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	base, bounded, err := s.base(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for ; !bounded || k >= base; k-- {
+		c, skipped, err := s.slot(ctx, k)
 		if err != nil {
 			return time.Time{}, err
 		}
+		if !skipped && c.Before(t) {
+			return c, nil
+		}
+	}
+	return time.Time{}, ErrNoEarlierMeetings
+}
+
+/*
+OccurrenceAt returns the time of the nth meeting, counting from zero for the first meeting.
+For unbounded schedules, First is counted as meeting zero, and earlier meetings have negative indexes.
+
+The time is calculated directly, so finding a meeting far from First is no slower than
+finding the next meeting.
+
+If the nth meeting is cancelled under the Schedule's DSTPolicy, ErrSkippedMeeting will be returned.
+Skipped meetings keep their index, so the numbering of other meetings is unaffected. Meetings more than
+a million years from First are not calculated, and ErrOutOfRange is returned for them.
+
+For floating schedules, the meeting is returned in the Location of First.
+*/
+func (s Schedule) OccurrenceAt(n int) (time.Time, error) {
+	c, skipped, err := s.slotAt(context.Background(), n)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if skipped {
+		return time.Time{}, ErrSkippedMeeting
+	}
+	return c, nil
+}
+
+// slotAt returns the time of the nth meeting, counting from zero for the first, and whether it is
+// skipped under the Schedule's DSTPolicy.
+func (s Schedule) slotAt(ctx context.Context, n int) (t time.Time, skipped bool, err error) {
+	if err := s.Validate(); err != nil {
+		return time.Time{}, false, err
+	}
+	base, bounded, err := s.base(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if bounded && n < 0 {
+		return time.Time{}, false, ErrNoEarlierMeetings
+	}
+	return s.slot(ctx, base+n)
+}
+
+/*
+IndexOf identifies the meeting at a given time, acting as the inverse of OccurrenceAt.
+
+If t is the time of a meeting, n will be the index of that meeting and ok will be true.
+Otherwise, ok will be false and n will be the index of the next meeting after t.
+*/
+func (s Schedule) IndexOf(t time.Time) (n int, ok bool, err error) {
+	return s.indexOf(context.Background(), t)
+}
+
+func (s Schedule) indexOf(ctx context.Context, t time.Time) (n int, ok bool, err error) {
+	s = s.in(t.Location())
+	k, err := s.floor(ctx, t)
+	if err != nil {
+		return 0, false, err
+	}
+	base, bounded, err := s.base(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if bounded && k < base {
+		return 0, false, nil
+	}
+	c, skipped, err := s.slot(ctx, k)
+	if err != nil {
+		return 0, false, err
+	}
+	if c.Equal(t) && !skipped {
+		return k - base, true, nil
+	}
+	return k + 1 - base, false, nil
+}
+
+/*
+Count returns the number of meetings after from and before to.
+
+Meetings at exactly from or to are not counted, so the result matches the number of meetings
+that would be found by calling Next repeatedly, starting from from, until reaching to.
+*/
+func (s Schedule) Count(from, to time.Time) (int, error) {
+	s = s.in(from.Location())
+	to = to.In(from.Location())
+	first, ok, err := s.IndexOf(from)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		first++
+	}
+	last, _, err := s.IndexOf(to)
+	if err != nil {
+		return 0, err
+	}
+	if last < first {
+		return 0, nil
+	}
+	skipped, err := s.skippedBetween(context.Background(), first, last)
+	if err != nil {
+		return 0, err
+	}
+	return last - first - skipped, nil
+}
+
+// IsOccurrence returns true if a meeting in this Schedule takes place at exactly the given time.
+func (s Schedule) IsOccurrence(t time.Time) bool {
+	_, ok, err := s.IndexOf(t)
+	return err == nil && ok
+}
+
+// IsOccurrenceWithin returns true if a meeting in this Schedule takes place no more than
+// tolerance before or after the given time.
+func (s Schedule) IsOccurrenceWithin(t time.Time, tolerance time.Duration) bool {
+	s = s.in(t.Location())
+	n, ok, err := s.IndexOf(t)
+	if err != nil {
+		return false
+	}
+	if ok {
+		return true
+	}
+	prev, err := s.OccurrenceAt(n - 1)
+	if err == nil && t.Sub(prev) <= tolerance {
+		return true
+	}
+	next, err := s.OccurrenceAt(n)
+	return err == nil && next.Sub(t) <= tolerance
+}
+
+// base returns the index, relative to First, of the first meeting in the series. If the schedule
+// is unbounded, bounded will be false, and First is treated as the first meeting for the purposes of numbering.
+func (s Schedule) base(ctx context.Context) (k int, bounded bool, err error) {
+	if s.Start.IsZero() {
+		return 0, !s.Unbounded, nil
+	}
+	start := s.Start
+	if s.Floating {
+		start = time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), s.location())
+	}
+	k, err = s.floor(ctx, start)
+	if err != nil {
+		return 0, false, err
+	}
+	c, err := s.occurrence(ctx, k)
+	if err != nil {
+		return 0, false, err
+	}
+	if c.Before(start) {
+		k++
+	}
+	return k, true, nil
+}
+
+// floor returns the index, relative to First, of the last meeting in the pattern at or before t.
+// This will be negative for times before First.
+func (s Schedule) floor(ctx context.Context, t time.Time) (int, error) {
+	if err := s.Validate(); err != nil {
+		return 0, err
+	}
+	k, err := s.estimate(t)
+	if err != nil {
+		return 0, err
+	}
+	// The estimate is based on calendar dates, so may be out by one either way
+	// depending on the time of day, or days missing from shorter months.
+	c, err := s.occurrence(ctx, k)
+	for err == nil && c.After(t) {
+		k--
+		c, err = s.occurrence(ctx, k)
+	}
+	for err == nil {
+		c, err = s.occurrence(ctx, k+1)
+		if err != nil || c.After(t) {
+			break
+		}
+		k++
 	}
-	return prev, nil
+	return k, err
 }
 
-func (s *Schedule) increment(t time.Time) (time.Time, error) {
-	if s.Type == Daily {
-		return t.AddDate(0, 0, int(s.Frequency)), nil
+// estimate returns the approximate index of the last meeting at or before t, based on the
+// number of calendar days, months or years between the first meeting and t.
+func (s Schedule) estimate(t time.Time) (int, error) {
+	first := s.anchor()
+	t = t.In(s.location())
+	switch s.Type {
+	case Daily:
+		return dates.FloorDiv(dates.DayNumber(t)-dates.DayNumber(first), int(s.Frequency)), nil
+	case Weekly:
+		return dates.FloorDiv(dates.DayNumber(t)-dates.DayNumber(first), 7*int(s.Frequency)), nil
+	case Monthly:
+		return dates.FloorDiv(dates.MonthNumber(t)-dates.MonthNumber(first), int(s.Frequency)), nil
+	case MonthlyByWeekday:
+		weekday, n := GetWeekdayAndIndex(first)
+		return qualifyingMonths(weekday, n, dates.MonthNumber(t)) - qualifyingMonths(weekday, n, dates.MonthNumber(first)), nil
+	case Yearly:
+		return dates.FloorDiv(t.Year()-first.Year(), int(s.Frequency)), nil
 	}
-	if s.Type == Weekly {
-		return t.AddDate(0, 0, 7*int(s.Frequency)), nil
+	return 0, ErrUnknownScheduleType
+}
+
+// occurrence returns the time of the kth meeting after the first, including meetings
+// skipped under the Schedule's DSTPolicy.
+func (s Schedule) occurrence(ctx context.Context, k int) (time.Time, error) {
+	t, _, err := s.slot(ctx, k)
+	return t, err
+}
+
+// slot returns the time of the kth meeting after the first. Negative values of k
+// extend the pattern back before the first meeting. If the meeting should not take place
+// under the Schedule's DSTPolicy, skipped will be true.
+func (s Schedule) slot(ctx context.Context, k int) (t time.Time, skipped bool, err error) {
+	if err := step(ctx); err != nil {
+		return time.Time{}, false, err
+	}
+	if !s.inRange(k) {
+		return time.Time{}, false, ErrOutOfRange
 	}
-	if s.Type == Monthly {
-		return t.AddDate(0, int(s.Frequency), 0), nil
+	f := s.anchor()
+	var date time.Time
+	switch s.Type {
+	case Daily:
+		date = time.Date(f.Year(), f.Month(), f.Day()+k*int(s.Frequency), 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		date = time.Date(f.Year(), f.Month(), f.Day()+7*k*int(s.Frequency), 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		month := dates.MonthNumber(f) + k*int(s.Frequency)
+		date = time.Date(dates.FloorDiv(month, 12), time.Month(dates.Mod(month, 12)+1), clampDay(f.Day(), month), 0, 0, 0, 0, time.UTC)
+	case MonthlyByWeekday:
+		// Identify the weekday and index, and find the kth month after the first to contain it
+		weekday, n := GetWeekdayAndIndex(f)
+		month := qualifyingMonth(weekday, n, qualifyingMonths(weekday, n, dates.MonthNumber(f))+k)
+		date = time.Date(dates.FloorDiv(month, 12), time.Month(dates.Mod(month, 12)+1), nthWeekday(month, weekday, n), 0, 0, 0, 0, time.UTC)
+	case Yearly:
+		month := dates.MonthNumber(f) + 12*k*int(s.Frequency)
+		date = time.Date(dates.FloorDiv(month, 12), f.Month(), clampDay(f.Day(), month), 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}, false, ErrUnknownScheduleType
+	}
+	t, skipped = s.localTime(date.Year(), date.Month(), date.Day(), f, s.location())
+	return t, skipped, nil
+}
+
+// maxYears limits how far meetings may be from the first, so that the calendar arithmetic in slot cannot overflow
+const maxYears = 1000000
+
+// inRange returns true if the kth meeting after the first is within maxYears of it.
+func (s Schedule) inRange(k int) bool {
+	var perYear, frequency uint = 1, s.Frequency
+	switch s.Type {
+	case Daily:
+		perYear = 366
+	case Weekly:
+		perYear = 53
+	case Monthly:
+		perYear = 12
+	case MonthlyByWeekday:
+		perYear, frequency = 12, 1
 	}
-	if s.Type == MonthlyByWeekday {
-		// Identify the weekday and index
-		weekday, n := GetWeekdayAndIndex(s.First)
-		c := t.AddDate(0, 0, 1)
-		w, cn := GetWeekdayAndIndex(c)
-		for w != weekday || n != cn {
-			c = c.AddDate(0, 0, 1)
-			w, cn = GetWeekdayAndIndex(c)
+	limit := int(maxYears * perYear / frequency)
+	return k >= -limit && k <= limit
+}
+
+// skippedBetween returns the number of meetings with indexes from first up to, but not including, last,
+// that are skipped under the Schedule's DSTPolicy.
+func (s Schedule) skippedBetween(ctx context.Context, first, last int) (int, error) {
+	if (s.Gap != DSTSkip && s.Overlap != DSTSkip) || first >= last {
+		return 0, nil
+	}
+	base, _, err := s.base(ctx)
+	if err != nil {
+		return 0, err
+	}
+	first, last = first+base, last+base
+	from, err := s.occurrence(ctx, first)
+	if err != nil {
+		return 0, err
+	}
+	to, err := s.occurrence(ctx, last)
+	if err != nil {
+		return 0, err
+	}
+	// Only meetings within a few hours of a transition can be skipped, so check
+	// the meetings around each transition in turn.
+	const window = 3 * time.Hour
+	var count int
+	checked := first - 1
+	for t := from.Add(-window); t.Before(to); {
+		transition := dates.NextTransition(t)
+		if transition.IsZero() {
+			break
 		}
-		return c, nil
+		k, err := s.floor(ctx, transition.Add(-window))
+		if err != nil {
+			return 0, err
+		}
+		for ; k < last; k++ {
+			c, skipped, err := s.slot(ctx, k)
+			if err != nil {
+				return 0, err
+			}
+			if c.After(transition.Add(window)) {
+				break
+			}
+			if skipped && k >= first && k > checked {
+				count++
+				checked = k
+			}
+		}
+		t = transition
+	}
+	return count, nil
+}
+
+// anchor returns the date and clock time of First in the Schedule's time zone.
+// For floating schedules, the clock time is returned in UTC, since it is independent of any time zone.
+func (s Schedule) anchor() time.Time {
+	if s.Floating {
+		f := s.First
+		return time.Date(f.Year(), f.Month(), f.Day(), f.Hour(), f.Minute(), f.Second(), f.Nanosecond(), time.UTC)
+	}
+	return s.First.In(s.location())
+}
+
+// location returns the time zone in which meetings are held.
+func (s Schedule) location() *time.Location {
+	if s.Floating && s.viewer != nil {
+		return s.viewer
+	}
+	if s.Location != nil {
+		return s.Location
 	}
-	if s.Type == Yearly {
-		return t.AddDate(int(s.Frequency), 0, 0), nil
+	return s.First.Location()
+}
+
+// in returns a copy of the Schedule to be evaluated in loc, if it is floating.
+func (s Schedule) in(loc *time.Location) Schedule {
+	if s.Floating {
+		s.viewer = loc
 	}
-	return time.Time{}, errors.New("not implemented")
+	return s
 }
 
 // GetWeekdayAndIndex returns the Weekday of a given time, along with the count of that particular
@@ -1,23 +1,47 @@
 package meetingtime
 
 import (
-	"errors"
+	"context"
+	"sort"
 	"time"
 )
 
 // ScheduleSlice allows Schedule instances to be grouped to create more complex schedules.
 type ScheduleSlice []Schedule
 
+// Validate checks that the slice contains at least one Schedule, and that every Schedule is valid.
+func (schedules ScheduleSlice) Validate() error {
+	if len(schedules) == 0 {
+		return ErrEmptySchedule
+	}
+	for _, s := range schedules {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 /*
 Next returns the earliest next meeting from all Schedules in the slice.
 */
 func (schedules ScheduleSlice) Next(t time.Time) (time.Time, error) {
+	return schedules.NextContext(context.Background(), t)
+}
+
+/*
+NextContext returns the earliest next meeting from all Schedules in the slice.
+
+If ctx is cancelled, or the budget set with WithEvaluationBudget is used up, evaluation will stop
+and the error will be returned.
+*/
+func (schedules ScheduleSlice) NextContext(ctx context.Context, t time.Time) (time.Time, error) {
 	if len(schedules) == 0 {
-		return time.Time{}, errors.New("no schedules")
+		return time.Time{}, ErrEmptySchedule
 	}
 	var next *time.Time
 	for _, s := range schedules {
-		sn, err := s.Next(t)
+		sn, err := s.NextContext(ctx, t)
 		if err != nil {
 			return time.Time{}, err
 		}
@@ -32,9 +56,22 @@ func (schedules ScheduleSlice) Next(t time.Time) (time.Time, error) {
 Previous returns the latest previous from all Schedules in the slice.
 */
 func (schedules ScheduleSlice) Previous(t time.Time) (time.Time, error) {
+	return schedules.PreviousContext(context.Background(), t)
+}
+
+/*
+PreviousContext returns the latest previous from all Schedules in the slice.
+
+If ctx is cancelled, or the budget set with WithEvaluationBudget is used up, evaluation will stop
+and the error will be returned.
+*/
+func (schedules ScheduleSlice) PreviousContext(ctx context.Context, t time.Time) (time.Time, error) {
+	if len(schedules) == 0 {
+		return time.Time{}, ErrEmptySchedule
+	}
 	var next *time.Time
 	for _, s := range schedules {
-		sn, err := s.Previous(t)
+		sn, err := s.PreviousContext(ctx, t)
 		if err != nil {
 			if err == ErrNoEarlierMeetings {
 				continue
@@ -50,3 +87,151 @@ func (schedules ScheduleSlice) Previous(t time.Time) (time.Time, error) {
 	}
 	return *next, nil
 }
+
+/*
+OccurrenceAt returns the time of the nth meeting from all Schedules in the slice, counting from
+zero for the earliest first meeting.
+
+Meetings that coincide in more than one Schedule are counted once for each Schedule. Meetings skipped
+under the DSTPolicy of their Schedule keep their index, as with Schedule.OccurrenceAt, and ErrSkippedMeeting
+is returned for them.
+*/
+func (schedules ScheduleSlice) OccurrenceAt(n int) (time.Time, error) {
+	if err := schedules.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	for i, s := range schedules {
+		j, ok, err := schedules.search(i, n)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			return s.OccurrenceAt(j)
+		}
+	}
+	return time.Time{}, ErrNoEarlierMeetings
+}
+
+/*
+IndexOf identifies the meeting at a given time, acting as the inverse of OccurrenceAt.
+
+If t is the time of a meeting in any of the Schedules, n will be the index of that meeting and ok will be true.
+Otherwise, ok will be false and n will be the index of the next meeting after t.
+*/
+func (schedules ScheduleSlice) IndexOf(t time.Time) (n int, ok bool, err error) {
+	if len(schedules) == 0 {
+		return 0, false, ErrEmptySchedule
+	}
+	for _, s := range schedules {
+		sn, sok, err := s.IndexOf(t)
+		if err != nil {
+			return 0, false, err
+		}
+		n += sn
+		ok = ok || sok
+	}
+	return n, ok, nil
+}
+
+/*
+Count returns the number of meetings from all Schedules in the slice after from and before to.
+
+Meetings that coincide in more than one Schedule are counted once for each Schedule.
+*/
+func (schedules ScheduleSlice) Count(from, to time.Time) (int, error) {
+	if len(schedules) == 0 {
+		return 0, ErrEmptySchedule
+	}
+	var count int
+	for _, s := range schedules {
+		c, err := s.Count(from, to)
+		if err != nil {
+			return 0, err
+		}
+		count += c
+	}
+	return count, nil
+}
+
+// IsOccurrence returns true if a meeting in any of the Schedules takes place at exactly the given time.
+func (schedules ScheduleSlice) IsOccurrence(t time.Time) bool {
+	for _, s := range schedules {
+		if s.IsOccurrence(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOccurrenceWithin returns true if a meeting in any of the Schedules takes place no more than
+// tolerance before or after the given time.
+func (schedules ScheduleSlice) IsOccurrenceWithin(t time.Time, tolerance time.Duration) bool {
+	for _, s := range schedules {
+		if s.IsOccurrenceWithin(t, tolerance) {
+			return true
+		}
+	}
+	return false
+}
+
+// search finds the index within the ith Schedule of the nth meeting in the slice.
+// If the nth meeting is not part of the ith Schedule, ok will be false.
+func (schedules ScheduleSlice) search(i, n int) (j int, ok bool, err error) {
+	r, err := schedules.rank(i, 0)
+	if err != nil {
+		return 0, false, err
+	}
+	if r == n {
+		return 0, true, nil
+	}
+	// The rank of a meeting increases by at least one with each meeting in its own Schedule,
+	// so the difference between r and n limits the range to be searched.
+	lo, hi := 1, n-r
+	if r > n {
+		lo, hi = n-r, -1
+	}
+	j = lo + sort.Search(hi-lo+1, func(x int) bool {
+		if err != nil {
+			return true
+		}
+		var rerr error
+		r, rerr = schedules.rank(i, lo+x)
+		if rerr == ErrNoEarlierMeetings {
+			return false
+		}
+		err = rerr
+		return err != nil || r >= n
+	})
+	if err != nil || j > hi {
+		return 0, false, err
+	}
+	r, err = schedules.rank(i, j)
+	if err == ErrNoEarlierMeetings {
+		return 0, false, nil
+	}
+	return j, err == nil && r == n, err
+}
+
+// rank returns the index within the slice of the jth meeting of the ith Schedule, including meetings
+// skipped under its DSTPolicy. Where meetings coincide, those from earlier Schedules in the slice are ranked first.
+func (schedules ScheduleSlice) rank(i, j int) (int, error) {
+	t, _, err := schedules[i].slotAt(context.Background(), j)
+	if err != nil {
+		return 0, err
+	}
+	r := j
+	for l, s := range schedules {
+		if l == i {
+			continue
+		}
+		n, ok, err := s.IndexOf(t)
+		if err != nil {
+			return 0, err
+		}
+		r += n
+		if ok && l < i {
+			r++
+		}
+	}
+	return r, nil
+}
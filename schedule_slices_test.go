@@ -142,3 +142,201 @@ func TestPreviousScheduleSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestOccurrenceAtScheduleSlice(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewWeeklySchedule(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC), 4),
+	}
+	c := schedules[1].First
+	for n := 0; n < 100; {
+		i, ok, err := schedules.IndexOf(c)
+		if err != nil || !ok || i != n {
+			t.Fatalf("IndexOf '%v': expected %v got %v (%v), %v", c, n, i, ok, err)
+		}
+		// Coinciding meetings are counted once for each Schedule
+		for _, s := range schedules {
+			if _, ok, _ := s.IndexOf(c); !ok {
+				continue
+			}
+			o, err := schedules.OccurrenceAt(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !o.Equal(c) {
+				t.Fatalf("meeting %d: expected '%v' got '%v'", n, c, o)
+			}
+			n++
+		}
+		c, err = schedules.Next(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIndexOfScheduleSlice(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	n, ok, err := schedules.IndexOf(time.Date(2016, time.October, 17, 19, 0, 0, 0, time.UTC))
+	if err != nil || !ok || n != 3 {
+		t.Errorf("expected 3 (true), got %v (%v), %v", n, ok, err)
+	}
+	n, ok, err = schedules.IndexOf(time.Date(2016, time.October, 18, 19, 0, 0, 0, time.UTC))
+	if err != nil || ok || n != 4 {
+		t.Errorf("expected 4 (false), got %v (%v), %v", n, ok, err)
+	}
+	if _, _, err = (ScheduleSlice{}).IndexOf(time.Now()); err == nil {
+		t.Errorf("expected an error for an empty slice")
+	}
+}
+
+func TestIsOccurrenceScheduleSlice(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	if !schedules.IsOccurrence(time.Date(2016, time.October, 17, 19, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 3rd Monday in October to be a meeting")
+	}
+	if schedules.IsOccurrence(time.Date(2016, time.October, 10, 19, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2nd Monday in October not to be a meeting")
+	}
+	if !schedules.IsOccurrenceWithin(time.Date(2016, time.October, 3, 18, 45, 0, 0, time.UTC), 15*time.Minute) {
+		t.Errorf("expected 15 minutes before the 1st Monday in October to be within tolerance")
+	}
+	if (ScheduleSlice{}).IsOccurrence(time.Now()) {
+		t.Errorf("expected no meetings in an empty slice")
+	}
+}
+
+func TestCountScheduleSlice(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	from := time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)
+	to := time.Date(2016, time.December, 19, 19, 0, 0, 0, time.UTC)
+	count, err := schedules.Count(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected int
+	for c, err := schedules.Next(from); c.Before(to); c, err = schedules.Next(c) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected++
+	}
+	if count != expected {
+		t.Errorf("count: expected %v got %v", expected, count)
+	}
+}
+
+func TestValidateScheduleSlice(t *testing.T) {
+	var tests = []struct {
+		name        string
+		schedules   ScheduleSlice
+		expectedErr error
+	}{
+		{
+			name: "Valid",
+			schedules: ScheduleSlice{
+				NewDailySchedule(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), 1),
+			},
+		},
+		{
+			name:        "Empty",
+			schedules:   ScheduleSlice{},
+			expectedErr: ErrEmptySchedule,
+		},
+		{
+			name: "Invalid member",
+			schedules: ScheduleSlice{
+				NewDailySchedule(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), 1),
+				Schedule{Type: Weekly, First: time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			expectedErr: ErrZeroFrequency,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.schedules.Validate(); err != test.expectedErr {
+				t.Errorf("Validate: expected '%v' got '%v'", test.expectedErr, err)
+			}
+			in := time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC)
+			if _, err := test.schedules.Next(in); err != test.expectedErr {
+				t.Errorf("Next: expected '%v' got '%v'", test.expectedErr, err)
+			}
+			if _, err := test.schedules.Previous(in); err != test.expectedErr {
+				t.Errorf("Previous: expected '%v' got '%v'", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestOccurrenceAtScheduleSliceUnbounded(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+		Schedule{Type: Weekly, First: time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC), Frequency: 3, Unbounded: true},
+	}
+	var prev time.Time
+	for n := -50; n < 50; n++ {
+		o, err := schedules.OccurrenceAt(n)
+		if err != nil {
+			t.Fatalf("meeting %d: %v", n, err)
+		}
+		if o.Before(prev) {
+			t.Fatalf("meeting %d: '%v' is before the previous meeting", n, o)
+		}
+		prev = o
+		// Where meetings coincide, IndexOf returns the first index
+		i, ok, err := schedules.IndexOf(o)
+		if err != nil || !ok || i > n {
+			t.Fatalf("IndexOf '%v': expected %v got %v (%v), %v", o, n, i, ok, err)
+		}
+		if first, _ := schedules.OccurrenceAt(i); !first.Equal(o) {
+			t.Fatalf("meeting %d: expected '%v' got '%v'", i, o, first)
+		}
+	}
+}
+
+func TestOccurrenceAtScheduleSliceSkipped(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// Clocks go forward at 02:00 on March 13th 2016, so the first Schedule skips its meeting that day
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2016, time.March, day, hour, minute, 0, 0, newYork)
+	}
+	schedules := ScheduleSlice{
+		{Type: Daily, First: at(10, 2, 30), Frequency: 1, Gap: DSTSkip},
+		NewDailySchedule(at(10, 12, 0), 1),
+	}
+	expected := []time.Time{
+		at(10, 2, 30), at(10, 12, 0), at(11, 2, 30), at(11, 12, 0), at(12, 2, 30), at(12, 12, 0),
+		{}, at(13, 12, 0), at(14, 2, 30), at(14, 12, 0), at(15, 2, 30), at(15, 12, 0),
+	}
+	for n, e := range expected {
+		o, err := schedules.OccurrenceAt(n)
+		if e.IsZero() {
+			if err != ErrSkippedMeeting {
+				t.Errorf("meeting %d: expected ErrSkippedMeeting, got '%v', %v", n, o, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("meeting %d: %v", n, err)
+		}
+		if !o.Equal(e) {
+			t.Errorf("meeting %d: expected '%v' got '%v'", n, e, o)
+		}
+		if i, ok, err := schedules.IndexOf(o); err != nil || !ok || i != n {
+			t.Errorf("IndexOf '%v': expected %v got %v (%v), %v", o, n, i, ok, err)
+		}
+	}
+}
@@ -205,3 +205,612 @@ func TestGetWeekdayAndN(t *testing.T) {
 		})
 	}
 }
+
+func TestOccurrenceAt(t *testing.T) {
+	var tests = []struct {
+		name         string
+		schedule     Schedule
+		n            int
+		expectedTime time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "First meeting",
+			schedule:     NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			n:            0,
+			expectedTime: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Every 3 days",
+			schedule:     NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 3),
+			n:            10,
+			expectedTime: time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Every other week",
+			schedule:     NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			n:            56,
+			expectedTime: time.Date(2018, time.February, 26, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Monthly on the 31st",
+			schedule:     NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1),
+			n:            2,
+			expectedTime: time.Date(2016, time.March, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Monthly on the 31st, short month",
+			schedule:     NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1),
+			n:            3,
+			expectedTime: time.Date(2016, time.April, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "3rd Monday",
+			schedule:     NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+			n:            57,
+			expectedTime: time.Date(2021, time.June, 21, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "5th Sunday",
+			schedule:     NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 29, 0, 0, 0, 0, time.UTC)),
+			n:            1,
+			expectedTime: time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Every 2 years",
+			schedule:     NewYearlySchedule(time.Date(2016, time.February, 29, 0, 0, 0, 0, time.UTC), 2),
+			n:            1,
+			expectedTime: time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "Negative index",
+			schedule:    NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			n:           -1,
+			expectedErr: ErrNoEarlierMeetings,
+		},
+		{
+			name:        "Out of range",
+			schedule:    NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			n:           1 << 62,
+			expectedErr: ErrOutOfRange,
+		},
+		{
+			name:        "Out of range with a large frequency",
+			schedule:    NewYearlySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1<<40),
+			n:           1,
+			expectedErr: ErrOutOfRange,
+		},
+		{
+			name:        "Out of range before the first meeting",
+			schedule:    Schedule{Type: Weekly, First: time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), Frequency: 1, Unbounded: true},
+			n:           -1 << 62,
+			expectedErr: ErrOutOfRange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			outTime, outErr := test.schedule.OccurrenceAt(test.n)
+			if outErr != test.expectedErr {
+				t.Errorf("error: expected '%v' got '%v'", test.expectedErr, outErr)
+			} else if test.expectedTime != outTime {
+				t.Errorf("times: expected '%v' got '%v'", test.expectedTime, outTime)
+			}
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	var tests = []struct {
+		name       string
+		schedule   Schedule
+		inTime     time.Time
+		expectedN  int
+		expectedOk bool
+	}{
+		{
+			name:       "First meeting",
+			schedule:   NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1),
+			inTime:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+			expectedN:  0,
+			expectedOk: true,
+		},
+		{
+			name:       "Before first meeting",
+			schedule:   NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1),
+			inTime:     time.Date(2015, time.January, 4, 9, 0, 0, 0, time.UTC),
+			expectedN:  0,
+			expectedOk: false,
+		},
+		{
+			name:       "Meeting",
+			schedule:   NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			inTime:     time.Date(2018, time.February, 26, 9, 0, 0, 0, time.UTC),
+			expectedN:  56,
+			expectedOk: true,
+		},
+		{
+			name:       "Meeting in another zone",
+			schedule:   NewWeeklySchedule(time.Date(2016, time.January, 4, 23, 0, 0, 0, time.UTC), 2),
+			inTime:     time.Date(2016, time.January, 19, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			expectedN:  1,
+			expectedOk: true,
+		},
+		{
+			name:       "Between meetings",
+			schedule:   NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			inTime:     time.Date(2016, time.January, 18, 9, 0, 0, 1, time.UTC),
+			expectedN:  2,
+			expectedOk: false,
+		},
+		{
+			name:       "Later in the day",
+			schedule:   NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			inTime:     time.Date(2016, time.January, 3, 10, 0, 0, 0, time.UTC),
+			expectedN:  3,
+			expectedOk: false,
+		},
+		{
+			name:       "Monthly on the 31st, short month",
+			schedule:   NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, time.UTC), 1),
+			inTime:     time.Date(2016, time.February, 29, 9, 0, 0, 0, time.UTC),
+			expectedN:  1,
+			expectedOk: true,
+		},
+		{
+			name:       "5th Sunday, month without one",
+			schedule:   NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 29, 0, 0, 0, 0, time.UTC)),
+			inTime:     time.Date(2015, time.December, 27, 0, 0, 0, 0, time.UTC),
+			expectedN:  1,
+			expectedOk: false,
+		},
+		{
+			name:       "5th Sunday, centuries later",
+			schedule:   NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 29, 0, 0, 0, 0, time.UTC)),
+			inTime:     time.Date(2416, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expectedN:  1672,
+			expectedOk: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n, ok, err := test.schedule.IndexOf(test.inTime)
+			if err != nil {
+				t.Errorf("error: %v", err)
+			}
+			if n != test.expectedN || ok != test.expectedOk {
+				t.Errorf("index: expected %v (%v) got %v (%v)", test.expectedN, test.expectedOk, n, ok)
+			}
+		})
+	}
+}
+
+// Meetings on days missing from short months are held on the last day of the month. Earlier versions rolled
+// them over into the next month, as with time.Time.AddDate, so this pins the change described in the README.
+func TestShortMonths(t *testing.T) {
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+	}
+	var tests = []struct {
+		name     string
+		schedule Schedule
+		expected []time.Time
+	}{
+		{
+			name:     "Monthly on the 31st",
+			schedule: NewMonthlySchedule(at(2016, time.January, 31), 1),
+			expected: []time.Time{
+				at(2016, time.February, 29), at(2016, time.March, 31), at(2016, time.April, 30),
+				at(2016, time.May, 31), at(2016, time.June, 30),
+			},
+		},
+		{
+			name:     "Monthly on the 30th",
+			schedule: NewMonthlySchedule(at(2017, time.January, 30), 1),
+			expected: []time.Time{
+				at(2017, time.February, 28), at(2017, time.March, 30), at(2017, time.April, 30),
+			},
+		},
+		{
+			name:     "Monthly on the 29th",
+			schedule: NewMonthlySchedule(at(2015, time.December, 29), 2),
+			expected: []time.Time{
+				at(2016, time.February, 29), at(2016, time.April, 29), at(2016, time.June, 29),
+			},
+		},
+		{
+			name:     "Leap day",
+			schedule: NewYearlySchedule(at(2016, time.February, 29), 1),
+			expected: []time.Time{
+				at(2017, time.February, 28), at(2018, time.February, 28), at(2019, time.February, 28),
+				at(2020, time.February, 29),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := test.schedule.First
+			for _, expected := range test.expected {
+				var err error
+				if c, err = test.schedule.Next(c); err != nil {
+					t.Fatal(err)
+				}
+				if !c.Equal(expected) {
+					t.Fatalf("expected '%v' got '%v'", expected, c)
+				}
+			}
+			for i := len(test.expected) - 2; i >= -1; i-- {
+				expected := test.schedule.First
+				if i >= 0 {
+					expected = test.expected[i]
+				}
+				var err error
+				if c, err = test.schedule.Previous(c); err != nil {
+					t.Fatal(err)
+				}
+				if !c.Equal(expected) {
+					t.Fatalf("expected '%v' got '%v'", expected, c)
+				}
+			}
+		})
+	}
+}
+
+func TestOccurrenceAtMatchesNext(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+	schedules := []Schedule{
+		NewDailySchedule(time.Date(2016, time.January, 1, 1, 30, 0, 0, london), 3),
+		NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 2),
+		NewMonthlySchedule(time.Date(2016, time.January, 31, 9, 0, 0, 0, london), 1),
+		NewMonthlySchedule(time.Date(2016, time.January, 29, 9, 0, 0, 0, london), 5),
+		NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 29, 18, 0, 0, 0, london)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, london)),
+		NewYearlySchedule(time.Date(2016, time.February, 29, 9, 0, 0, 0, london), 1),
+	}
+	for _, s := range schedules {
+		c := s.First
+		for n := 0; n < 200; n++ {
+			o, err := s.OccurrenceAt(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !o.Equal(c) {
+				t.Fatalf("%v meeting %d: expected '%v' got '%v'", s.Type, n, c, o)
+			}
+			i, ok, err := s.IndexOf(o)
+			if err != nil || !ok || i != n {
+				t.Fatalf("%v meeting %d: IndexOf returned %v (%v), %v", s.Type, n, i, ok, err)
+			}
+			c, err = s.Next(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestIsOccurrence(t *testing.T) {
+	schedule := NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2)
+	var tests = []struct {
+		name           string
+		inTime         time.Time
+		tolerance      time.Duration
+		expected       bool
+		expectedWithin bool
+	}{
+		{
+			name:           "First meeting",
+			inTime:         time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+			expected:       true,
+			expectedWithin: true,
+		},
+		{
+			name:           "Later meeting",
+			inTime:         time.Date(2016, time.February, 1, 9, 0, 0, 0, time.UTC),
+			expected:       true,
+			expectedWithin: true,
+		},
+		{
+			name:           "Off week",
+			inTime:         time.Date(2016, time.January, 11, 9, 0, 0, 0, time.UTC),
+			tolerance:      time.Hour,
+			expected:       false,
+			expectedWithin: false,
+		},
+		{
+			name:           "Before first meeting, within tolerance",
+			inTime:         time.Date(2016, time.January, 4, 8, 55, 0, 0, time.UTC),
+			tolerance:      5 * time.Minute,
+			expected:       false,
+			expectedWithin: true,
+		},
+		{
+			name:           "Before first meeting, outside tolerance",
+			inTime:         time.Date(2016, time.January, 4, 8, 54, 59, 0, time.UTC),
+			tolerance:      5 * time.Minute,
+			expected:       false,
+			expectedWithin: false,
+		},
+		{
+			name:           "After a meeting, within tolerance",
+			inTime:         time.Date(2016, time.January, 18, 9, 10, 0, 0, time.UTC),
+			tolerance:      15 * time.Minute,
+			expected:       false,
+			expectedWithin: true,
+		},
+		{
+			name:           "Before a meeting, within tolerance",
+			inTime:         time.Date(2016, time.January, 18, 8, 50, 0, 0, time.UTC),
+			tolerance:      15 * time.Minute,
+			expected:       false,
+			expectedWithin: true,
+		},
+		{
+			name:           "After a meeting, outside tolerance",
+			inTime:         time.Date(2016, time.January, 18, 9, 10, 0, 0, time.UTC),
+			tolerance:      5 * time.Minute,
+			expected:       false,
+			expectedWithin: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if out := schedule.IsOccurrence(test.inTime); out != test.expected {
+				t.Errorf("IsOccurrence: expected %v got %v", test.expected, out)
+			}
+			if out := schedule.IsOccurrenceWithin(test.inTime, test.tolerance); out != test.expectedWithin {
+				t.Errorf("IsOccurrenceWithin: expected %v got %v", test.expectedWithin, out)
+			}
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	var tests = []struct {
+		name     string
+		schedule Schedule
+		from     time.Time
+		to       time.Time
+		expected int
+	}{
+		{
+			name:     "Daily, exclusive of both ends",
+			schedule: NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			from:     time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC),
+			to:       time.Date(2016, time.January, 11, 9, 0, 0, 0, time.UTC),
+			expected: 9,
+		},
+		{
+			name:     "Daily, between meetings",
+			schedule: NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			from:     time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2016, time.January, 11, 0, 0, 0, 0, time.UTC),
+			expected: 10,
+		},
+		{
+			name:     "Starting before the first meeting",
+			schedule: NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			from:     time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: 26,
+		},
+		{
+			name:     "Entirely before the first meeting",
+			schedule: NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 2),
+			from:     time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "Reversed",
+			schedule: NewDailySchedule(time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), 1),
+			from:     time.Date(2016, time.January, 11, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "2nd Wednesday over a year",
+			schedule: NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 11, 18, 30, 0, 0, time.UTC)),
+			from:     time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: 12,
+		},
+		{
+			name:     "5th Sunday over a year",
+			schedule: NewMonthlyScheduleByWeekday(time.Date(2015, time.November, 29, 0, 0, 0, 0, time.UTC)),
+			from:     time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: 4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.schedule.Count(test.from, test.to)
+			if err != nil {
+				t.Errorf("error: %v", err)
+			} else if out != test.expected {
+				t.Errorf("count: expected %v got %v", test.expected, out)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		schedule    Schedule
+		expectedErr error
+	}{
+		{
+			name:     "Valid",
+			schedule: Schedule{Type: Weekly, First: time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), Frequency: 2},
+		},
+		{
+			name:     "Monthly by weekday ignores frequency",
+			schedule: Schedule{Type: MonthlyByWeekday, First: time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:        "Zero frequency",
+			schedule:    Schedule{Type: Daily, First: time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			expectedErr: ErrZeroFrequency,
+		},
+		{
+			name:        "Unknown type",
+			schedule:    Schedule{Type: 100, First: time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), Frequency: 1},
+			expectedErr: ErrUnknownScheduleType,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.schedule.Validate(); err != test.expectedErr {
+				t.Errorf("Validate: expected '%v' got '%v'", test.expectedErr, err)
+			}
+			in := time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC)
+			if _, err := test.schedule.Next(in); err != test.expectedErr {
+				t.Errorf("Next: expected '%v' got '%v'", test.expectedErr, err)
+			}
+			if _, err := test.schedule.Previous(in); err != test.expectedErr {
+				t.Errorf("Previous: expected '%v' got '%v'", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestNewSchedule(t *testing.T) {
+	first := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name         string
+		scheduleType ScheduleType
+		n            uint
+		expected     Schedule
+		expectedErr  error
+	}{
+		{name: "Weekly", scheduleType: Weekly, n: 2, expected: NewWeeklySchedule(first, 2)},
+		{name: "Monthly by weekday", scheduleType: MonthlyByWeekday, expected: NewMonthlyScheduleByWeekday(first)},
+		{name: "Zero frequency", scheduleType: Daily, expectedErr: ErrZeroFrequency},
+		{name: "Unknown type", scheduleType: ScheduleType(99), n: 1, expectedErr: ErrUnknownScheduleType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := NewSchedule(test.scheduleType, first, test.n)
+			if err != test.expectedErr {
+				t.Errorf("expected '%v' got '%v'", test.expectedErr, err)
+			}
+			if s != test.expected {
+				t.Errorf("expected %+v got %+v", test.expected, s)
+			}
+		})
+	}
+
+	// The constructors for each type do not validate, so they can be used in expressions
+	if err := NewDailySchedule(first, 0).Validate(); err != ErrZeroFrequency {
+		t.Errorf("expected '%v' got '%v'", ErrZeroFrequency, err)
+	}
+}
+
+func TestSeriesStart(t *testing.T) {
+	anchor := time.Date(2016, time.January, 5, 18, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name         string
+		schedule     Schedule
+		next         time.Time
+		expectedNext time.Time
+		prev         time.Time
+		expectedPrev time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "Unbounded, before anchor",
+			schedule:     Schedule{Type: Weekly, First: anchor, Frequency: 2, Unbounded: true},
+			next:         time.Date(2015, time.December, 1, 0, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2015, time.December, 8, 18, 0, 0, 0, time.UTC),
+			prev:         time.Date(2015, time.December, 1, 0, 0, 0, 0, time.UTC),
+			expectedPrev: time.Date(2015, time.November, 24, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Unbounded, at anchor",
+			schedule:     Schedule{Type: Weekly, First: anchor, Frequency: 2, Unbounded: true},
+			next:         anchor,
+			expectedNext: time.Date(2016, time.January, 19, 18, 0, 0, 0, time.UTC),
+			prev:         anchor,
+			expectedPrev: time.Date(2015, time.December, 22, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Unbounded, 2nd Tuesday",
+			schedule:     Schedule{Type: MonthlyByWeekday, First: anchor.AddDate(0, 0, 7), Unbounded: true},
+			next:         time.Date(1999, time.December, 1, 0, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(1999, time.December, 14, 18, 0, 0, 0, time.UTC),
+			prev:         time.Date(1999, time.December, 1, 0, 0, 0, 0, time.UTC),
+			expectedPrev: time.Date(1999, time.November, 9, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Start after anchor",
+			schedule:     Schedule{Type: Weekly, First: anchor, Frequency: 2, Start: time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			next:         anchor,
+			expectedNext: time.Date(2016, time.March, 1, 18, 0, 0, 0, time.UTC),
+			prev:         time.Date(2016, time.April, 1, 0, 0, 0, 0, time.UTC),
+			expectedPrev: time.Date(2016, time.March, 29, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "Start after anchor, no earlier meetings",
+			schedule:     Schedule{Type: Weekly, First: anchor, Frequency: 2, Start: time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			next:         anchor,
+			expectedNext: time.Date(2016, time.March, 1, 18, 0, 0, 0, time.UTC),
+			prev:         time.Date(2016, time.March, 1, 18, 0, 0, 0, time.UTC),
+			expectedErr:  ErrNoEarlierMeetings,
+		},
+		{
+			name:         "Start before anchor",
+			schedule:     Schedule{Type: Weekly, First: anchor, Frequency: 2, Start: time.Date(2015, time.December, 22, 18, 0, 0, 0, time.UTC)},
+			next:         time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2015, time.December, 22, 18, 0, 0, 0, time.UTC),
+			prev:         time.Date(2015, time.December, 22, 18, 0, 0, 0, time.UTC),
+			expectedErr:  ErrNoEarlierMeetings,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next, err := test.schedule.Next(test.next)
+			if err != nil {
+				t.Errorf("Next error: %v", err)
+			} else if !next.Equal(test.expectedNext) {
+				t.Errorf("Next: expected '%v' got '%v'", test.expectedNext, next)
+			}
+			prev, err := test.schedule.Previous(test.prev)
+			if err != test.expectedErr {
+				t.Errorf("Previous error: expected '%v' got '%v'", test.expectedErr, err)
+			} else if !prev.Equal(test.expectedPrev) {
+				t.Errorf("Previous: expected '%v' got '%v'", test.expectedPrev, prev)
+			}
+		})
+	}
+}
+
+func TestSeriesStartIndexes(t *testing.T) {
+	anchor := time.Date(2016, time.January, 5, 18, 0, 0, 0, time.UTC)
+
+	unbounded := Schedule{Type: Weekly, First: anchor, Frequency: 1, Unbounded: true}
+	o, err := unbounded.OccurrenceAt(-2)
+	if err != nil || !o.Equal(anchor.AddDate(0, 0, -14)) {
+		t.Errorf("unbounded: expected '%v' got '%v', %v", anchor.AddDate(0, 0, -14), o, err)
+	}
+	n, ok, err := unbounded.IndexOf(anchor.AddDate(0, 0, -14))
+	if err != nil || !ok || n != -2 {
+		t.Errorf("unbounded: expected -2 (true) got %v (%v), %v", n, ok, err)
+	}
+
+	started := Schedule{Type: Weekly, First: anchor, Frequency: 1, Start: anchor.AddDate(0, 0, 20)}
+	o, err = started.OccurrenceAt(0)
+	if err != nil || !o.Equal(anchor.AddDate(0, 0, 21)) {
+		t.Errorf("started: expected '%v' got '%v', %v", anchor.AddDate(0, 0, 21), o, err)
+	}
+	n, ok, err = started.IndexOf(anchor)
+	if err != nil || ok || n != 0 {
+		t.Errorf("started: expected 0 (false) got %v (%v), %v", n, ok, err)
+	}
+	if _, err = started.OccurrenceAt(-1); err != ErrNoEarlierMeetings {
+		t.Errorf("started: expected '%v' got '%v'", ErrNoEarlierMeetings, err)
+	}
+}
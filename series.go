@@ -0,0 +1,166 @@
+package meetingtime
+
+import (
+	"time"
+)
+
+/*
+Series is a set of Schedules with changes to individual meetings, such as a meeting cancelled for a holiday,
+an extra meeting before a deadline, or a meeting moved to avoid a clash.
+
+For floating Schedules, Cancelled, Added and Moved give the clock time of each meeting, which is evaluated in the
+time zone of the time passed to Next or Previous, as with the Schedules themselves.
+*/
+type Series struct {
+	Schedules ScheduleSlice // Regular meetings. May be empty if the Series only has added meetings.
+	Cancelled []time.Time   // Meetings from Schedules that do not take place
+	Added     []time.Time   // Meetings in addition to those from Schedules
+	Moved     []Move        // Meetings from Schedules that take place at a different time
+}
+
+// Move is a meeting from the Schedules of a Series that takes place at a different time.
+type Move struct {
+	From time.Time // Time of the meeting in the Schedules
+	To   time.Time // Time the meeting takes place instead
+}
+
+// Validate checks that the Series has at least one meeting, that every Schedule is valid, and that
+// every cancelled or moved meeting is a meeting in one of the Schedules.
+func (s Series) Validate() error {
+	if len(s.Schedules) == 0 && len(s.Added) == 0 {
+		return ErrEmptySchedule
+	}
+	for _, schedule := range s.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if schedule.Floating != s.floating() {
+			return ErrMixedFloating
+		}
+	}
+	for _, c := range s.Cancelled {
+		if len(s.Schedules) == 0 || !s.Schedules.IsOccurrence(c) {
+			return ErrNotScheduled
+		}
+	}
+	for _, m := range s.Moved {
+		if len(s.Schedules) == 0 || !s.Schedules.IsOccurrence(m.From) {
+			return ErrNotScheduled
+		}
+	}
+	return nil
+}
+
+/*
+Next returns the time of the next meeting after the given time, skipping cancelled meetings, and holding
+moved meetings at their new time.
+
+If the Series only has added meetings, and none are after the given time, ErrNoLaterMeetings will be returned.
+*/
+func (s Series) Next(t time.Time) (time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	var next time.Time
+	if len(s.Schedules) > 0 {
+		n, err := s.Schedules.Next(t)
+		for err == nil && s.skipped(n) {
+			n, err = s.Schedules.Next(n)
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+		next = n
+	}
+	for _, a := range s.extra() {
+		a = s.at(a, t.Location())
+		if a.After(t) && (next.IsZero() || a.Before(next)) {
+			next = a
+		}
+	}
+	if next.IsZero() {
+		return time.Time{}, ErrNoLaterMeetings
+	}
+	return next, nil
+}
+
+/*
+Previous returns the time of the closest meeting before the given time, skipping cancelled meetings, and holding
+moved meetings at their new time.
+
+If the given time is before the first meeting, ErrNoEarlierMeetings will be returned.
+*/
+func (s Series) Previous(t time.Time) (time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	var previous time.Time
+	if len(s.Schedules) > 0 {
+		p, err := s.Schedules.Previous(t)
+		for err == nil && s.skipped(p) {
+			p, err = s.Schedules.Previous(p)
+		}
+		if err != nil && err != ErrNoEarlierMeetings {
+			return time.Time{}, err
+		}
+		previous = p
+	}
+	for _, a := range s.extra() {
+		a = s.at(a, t.Location())
+		if a.Before(t) && (previous.IsZero() || a.After(previous)) {
+			previous = a
+		}
+	}
+	if previous.IsZero() {
+		return time.Time{}, ErrNoEarlierMeetings
+	}
+	return previous, nil
+}
+
+// IsCancelled returns true if the meeting at the given time has been cancelled.
+func (s Series) IsCancelled(t time.Time) bool {
+	for _, c := range s.Cancelled {
+		if s.at(c, t.Location()).Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// MovedTo returns the new time of the meeting at the given time, if it has been moved.
+func (s Series) MovedTo(t time.Time) (time.Time, bool) {
+	for _, m := range s.Moved {
+		if s.at(m.From, t.Location()).Equal(t) {
+			return s.at(m.To, t.Location()), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// skipped returns true if the meeting at the given time from the Schedules has been cancelled or moved.
+func (s Series) skipped(t time.Time) bool {
+	_, moved := s.MovedTo(t)
+	return moved || s.IsCancelled(t)
+}
+
+// extra returns the meetings that are not from the Schedules, added or moved.
+func (s Series) extra() []time.Time {
+	out := append([]time.Time(nil), s.Added...)
+	for _, m := range s.Moved {
+		out = append(out, m.To)
+	}
+	return out
+}
+
+// floating returns true if the Schedules in the Series are floating.
+func (s Series) floating() bool {
+	return len(s.Schedules) > 0 && s.Schedules[0].Floating
+}
+
+// at returns the time of a cancelled, added or moved meeting, evaluated in loc if the Series is floating.
+func (s Series) at(t time.Time, loc *time.Location) time.Time {
+	if !s.floating() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
@@ -0,0 +1,117 @@
+package meetingtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeries(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	series := Series{
+		Schedules: ScheduleSlice{NewWeeklySchedule(time.Date(2016, time.December, 5, 9, 0, 0, 0, london), 1)},
+		Cancelled: []time.Time{
+			time.Date(2016, time.December, 26, 9, 0, 0, 0, london),
+			time.Date(2017, time.January, 2, 9, 0, 0, 0, london),
+		},
+		Added: []time.Time{time.Date(2016, time.December, 23, 9, 0, 0, 0, london)},
+		Moved: []Move{{From: time.Date(2016, time.December, 12, 9, 0, 0, 0, london), To: time.Date(2016, time.December, 13, 15, 0, 0, 0, london)}},
+	}
+	expected := []time.Time{
+		time.Date(2016, time.December, 5, 9, 0, 0, 0, london),
+		time.Date(2016, time.December, 13, 15, 0, 0, 0, london),
+		time.Date(2016, time.December, 19, 9, 0, 0, 0, london),
+		time.Date(2016, time.December, 23, 9, 0, 0, 0, london),
+		time.Date(2017, time.January, 9, 9, 0, 0, 0, london),
+	}
+
+	next := time.Date(2016, time.December, 1, 0, 0, 0, 0, london)
+	for i, e := range expected {
+		var err error
+		if next, err = series.Next(next); err != nil {
+			t.Fatal(err)
+		}
+		if !next.Equal(e) {
+			t.Errorf("next %d: expected %v got %v", i, e, next)
+		}
+	}
+	previous := next
+	for i := len(expected) - 2; i >= 0; i-- {
+		var err error
+		if previous, err = series.Previous(previous); err != nil {
+			t.Fatal(err)
+		}
+		if !previous.Equal(expected[i]) {
+			t.Errorf("previous %d: expected %v got %v", i, expected[i], previous)
+		}
+	}
+	if _, err := series.Previous(previous); err != ErrNoEarlierMeetings {
+		t.Errorf("expected '%v' got '%v'", ErrNoEarlierMeetings, err)
+	}
+	if !series.IsCancelled(time.Date(2016, time.December, 26, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected meeting to be cancelled")
+	}
+	if to, ok := series.MovedTo(time.Date(2016, time.December, 12, 9, 0, 0, 0, london)); !ok || !to.Equal(series.Moved[0].To) {
+		t.Errorf("expected meeting to be moved to %v, got %v", series.Moved[0].To, to)
+	}
+}
+
+func TestSeriesAddedOnly(t *testing.T) {
+	series := Series{Added: []time.Time{
+		time.Date(2016, time.March, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2016, time.February, 1, 9, 0, 0, 0, time.UTC),
+	}}
+	next, err := series.Next(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || !next.Equal(series.Added[1]) {
+		t.Errorf("expected %v got %v, %v", series.Added[1], next, err)
+	}
+	if _, err := series.Next(series.Added[0]); err != ErrNoLaterMeetings {
+		t.Errorf("expected '%v' got '%v'", ErrNoLaterMeetings, err)
+	}
+}
+
+func TestSeriesFloating(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	series := Series{
+		Schedules: ScheduleSlice{{Type: Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true}},
+		Cancelled: []time.Time{time.Date(2016, time.January, 2, 9, 0, 0, 0, time.UTC)},
+	}
+	next, err := series.Next(time.Date(2016, time.January, 1, 12, 0, 0, 0, tokyo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2016, time.January, 3, 9, 0, 0, 0, tokyo); !next.Equal(expected) {
+		t.Errorf("expected %v got %v", expected, next)
+	}
+}
+
+func TestSeriesValidate(t *testing.T) {
+	weekly := NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC), 1)
+	floating := Schedule{Type: Daily, First: time.Date(2016, time.January, 1, 9, 0, 0, 0, time.UTC), Frequency: 1, Floating: true}
+	var tests = []struct {
+		name     string
+		series   Series
+		expected error
+	}{
+		{name: "Valid", series: Series{Schedules: ScheduleSlice{weekly}}},
+		{name: "Empty", series: Series{}, expected: ErrEmptySchedule},
+		{name: "Invalid schedule", series: Series{Schedules: ScheduleSlice{{Type: Daily}}}, expected: ErrZeroFrequency},
+		{name: "Mixed floating", series: Series{Schedules: ScheduleSlice{weekly, floating}}, expected: ErrMixedFloating},
+		{
+			name:     "Cancelled meeting not scheduled",
+			series:   Series{Schedules: ScheduleSlice{weekly}, Cancelled: []time.Time{time.Date(2016, time.January, 5, 9, 0, 0, 0, time.UTC)}},
+			expected: ErrNotScheduled,
+		},
+		{
+			name:     "Moved meeting not scheduled",
+			series:   Series{Schedules: ScheduleSlice{weekly}, Moved: []Move{{From: time.Date(2016, time.January, 5, 9, 0, 0, 0, time.UTC), To: time.Date(2016, time.January, 6, 9, 0, 0, 0, time.UTC)}}},
+			expected: ErrNotScheduled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.series.Validate(); err != test.expected {
+				t.Errorf("expected '%v' got '%v'", test.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package meetingtime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theothertomelliott/meetingtime/internal/dates"
+)
+
+const (
+	textMinuteLayout = "2006-01-02T15:04"
+	textLayout       = "2006-01-02T15:04:05.999999999"
+)
+
+// String returns the text form of the Schedule, as described for MarshalText.
+func (s Schedule) String() string {
+	return string(s.text())
+}
+
+/*
+MarshalText implements encoding.TextMarshaler, encoding the Schedule in a compact, human-readable form such as:
+
+	weekly/2@2016-01-04T09:00[Europe/London]
+
+The text form has the following grammar:
+
+	schedule  = type [ "/" frequency ] "@" datetime [ "[" zone "]" ] *( ";" option )
+	type      = "daily" / "weekly" / "monthly" / "monthly-by-weekday" / "yearly"
+	frequency = 1*DIGIT
+	datetime  = YYYY "-" MM "-" DD "T" hh ":" mm [ ":" ss [ "." fraction ] ]
+	zone      = IANA time zone name / ( "+" / "-" ) hh ":" mm
+	option    = "start=" datetime / "unbounded" / "gap=" policy / "overlap=" policy
+	policy    = "shift-back" / "shift-forward" / "skip"
+
+The datetime of the first meeting, and of any start option, is the local clock time in the zone.
+The frequency may be omitted if it is 1. A Schedule without a zone is floating. Zones that are not in the
+IANA database, such as those created with time.FixedZone, and time.Local, whose zone depends on the host,
+are written as their UTC offset.
+*/
+func (s Schedule) MarshalText() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s.text(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the form produced by MarshalText.
+// The decoded Schedule is validated before being returned.
+func (s *Schedule) UnmarshalText(text []byte) error {
+	out, err := parseScheduleText(string(text))
+	if err != nil {
+		return err
+	}
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*s = out
+	return nil
+}
+
+// String returns the text form of the slice, as described for MarshalText.
+func (schedules ScheduleSlice) String() string {
+	var parts []string
+	for _, s := range schedules {
+		parts = append(parts, s.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding each Schedule in the form described for
+// Schedule.MarshalText, separated by commas.
+func (schedules ScheduleSlice) MarshalText() ([]byte, error) {
+	if err := schedules.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(schedules.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the form produced by MarshalText.
+// The decoded slice is validated before being returned.
+func (schedules *ScheduleSlice) UnmarshalText(text []byte) error {
+	var out ScheduleSlice
+	if len(text) > 0 {
+		for _, part := range strings.Split(string(text), ",") {
+			s, err := parseScheduleText(part)
+			if err != nil {
+				return err
+			}
+			out = append(out, s)
+		}
+	}
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*schedules = out
+	return nil
+}
+
+func (s Schedule) text() []byte {
+	var b strings.Builder
+	b.WriteString(s.Type.String())
+	if s.Frequency != 1 && s.Type != MonthlyByWeekday {
+		fmt.Fprintf(&b, "/%d", s.Frequency)
+	}
+	b.WriteString("@")
+	if s.Floating {
+		b.WriteString(formatTextTime(s.First))
+	} else {
+		b.WriteString(formatTextTime(s.anchor()))
+		b.WriteString("[")
+		if name := dates.ZoneName(s.location()); name != "" {
+			b.WriteString(name)
+		} else {
+			b.WriteString(s.anchor().Format("-07:00"))
+		}
+		b.WriteString("]")
+	}
+	if !s.Start.IsZero() {
+		start := s.Start
+		if !s.Floating {
+			start = start.In(s.location())
+		}
+		b.WriteString(";start=")
+		b.WriteString(formatTextTime(start))
+	}
+	if s.Unbounded {
+		b.WriteString(";unbounded")
+	}
+	if s.Gap != DSTShiftBack {
+		fmt.Fprintf(&b, ";gap=%v", s.Gap)
+	}
+	if s.Overlap != DSTShiftBack {
+		fmt.Fprintf(&b, ";overlap=%v", s.Overlap)
+	}
+	return []byte(b.String())
+}
+
+func formatTextTime(t time.Time) string {
+	if t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format(textMinuteLayout)
+	}
+	return t.Format(textLayout)
+}
+
+func parseScheduleText(text string) (Schedule, error) {
+	var s Schedule
+	parts := strings.Split(strings.TrimSpace(text), ";")
+
+	at := strings.Index(parts[0], "@")
+	if at < 0 {
+		return Schedule{}, fmt.Errorf("schedule %q: missing '@'", text)
+	}
+	typeName, when := parts[0][:at], parts[0][at+1:]
+
+	s.Frequency = 1
+	if slash := strings.Index(typeName, "/"); slash >= 0 {
+		f, err := strconv.ParseUint(typeName[slash+1:], 10, 0)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("schedule %q: invalid frequency: %v", text, err)
+		}
+		s.Frequency = uint(f)
+		typeName = typeName[:slash]
+	}
+	if err := s.Type.UnmarshalText([]byte(typeName)); err != nil {
+		return Schedule{}, err
+	}
+
+	loc := time.UTC
+	if open := strings.Index(when, "["); open >= 0 {
+		if !strings.HasSuffix(when, "]") {
+			return Schedule{}, fmt.Errorf("schedule %q: missing ']'", text)
+		}
+		var err error
+		loc, err = parseTextZone(when[open+1 : len(when)-1])
+		if err != nil {
+			return Schedule{}, err
+		}
+		when = when[:open]
+	} else {
+		s.Floating = true
+	}
+	var err error
+	s.First, err = parseTextTime(when, loc)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	for _, option := range parts[1:] {
+		name, value := option, ""
+		if eq := strings.Index(option, "="); eq >= 0 {
+			name, value = option[:eq], option[eq+1:]
+		}
+		switch name {
+		case "start":
+			s.Start, err = parseTextTime(value, loc)
+		case "unbounded":
+			s.Unbounded = true
+		case "gap":
+			err = s.Gap.UnmarshalText([]byte(value))
+		case "overlap":
+			err = s.Overlap.UnmarshalText([]byte(value))
+		default:
+			err = fmt.Errorf("schedule %q: unknown option %q", text, name)
+		}
+		if err != nil {
+			return Schedule{}, err
+		}
+	}
+	return s, nil
+}
+
+func parseTextTime(value string, loc *time.Location) (time.Time, error) {
+	layout := textLayout
+	if len(value) == len(textMinuteLayout) {
+		layout = textMinuteLayout
+	}
+	return time.ParseInLocation(layout, value, loc)
+}
+
+func parseTextZone(zone string) (*time.Location, error) {
+	if strings.HasPrefix(zone, "+") || strings.HasPrefix(zone, "-") {
+		t, err := time.Parse("-07:00", zone)
+		if err != nil {
+			return nil, errors.New("invalid UTC offset: " + zone)
+		}
+		_, offset := t.Zone()
+		return time.FixedZone("", offset), nil
+	}
+	return time.LoadLocation(zone)
+}
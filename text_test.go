@@ -0,0 +1,130 @@
+package meetingtime
+
+import (
+	"flag"
+	"testing"
+	"time"
+)
+
+func TestScheduleText(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	var tests = []struct {
+		name     string
+		schedule Schedule
+		expected string
+	}{
+		{
+			name:     "Every other week",
+			schedule: NewWeeklySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, london), 2),
+			expected: "weekly/2@2016-01-04T09:00[Europe/London]",
+		},
+		{
+			name:     "Monthly by weekday",
+			schedule: NewMonthlyScheduleByWeekday(time.Date(2016, time.June, 14, 19, 30, 0, 0, london)),
+			expected: "monthly-by-weekday@2016-06-14T19:30[Europe/London]",
+		},
+		{
+			name:     "Seconds",
+			schedule: NewDailySchedule(time.Date(2016, time.January, 4, 9, 0, 30, 500000000, time.UTC), 1),
+			expected: "daily@2016-01-04T09:00:30.5[UTC]",
+		},
+		{
+			name:     "Fixed zone",
+			schedule: NewMonthlySchedule(time.Date(2016, time.January, 4, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)), 3),
+			expected: "monthly/3@2016-01-04T09:00[+09:00]",
+		},
+		{
+			name: "Options",
+			schedule: Schedule{
+				Type:      Yearly,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, london),
+				Frequency: 1,
+				Start:     time.Date(2017, time.January, 1, 0, 0, 0, 0, london),
+				Unbounded: true,
+				Gap:       DSTSkip,
+				Overlap:   DSTShiftForward,
+			},
+			expected: "yearly@2016-01-04T09:00[Europe/London];start=2017-01-01T00:00;unbounded;gap=skip;overlap=shift-forward",
+		},
+		{
+			name: "Floating",
+			schedule: Schedule{
+				Type:      Daily,
+				First:     time.Date(2016, time.January, 4, 9, 0, 0, 0, time.UTC),
+				Frequency: 1,
+				Floating:  true,
+			},
+			expected: "daily@2016-01-04T09:00",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.schedule.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != test.expected {
+				t.Errorf("marshal: expected %s got %s", test.expected, out)
+			}
+			if s := test.schedule.String(); s != test.expected {
+				t.Errorf("String: expected %s got %s", test.expected, s)
+			}
+			var decoded Schedule
+			if err := decoded.UnmarshalText(out); err != nil {
+				t.Fatal(err)
+			}
+			if again := decoded.String(); again != test.expected {
+				t.Errorf("round trip: expected %s got %s", test.expected, again)
+			}
+			if !test.schedule.Floating && !decoded.First.Equal(test.schedule.First) {
+				t.Errorf("first: expected '%v' got '%v'", test.schedule.First, decoded.First)
+			}
+		})
+	}
+}
+
+func TestScheduleTextErrors(t *testing.T) {
+	var tests = []string{
+		"",
+		"weekly",
+		"hourly@2016-01-04T09:00[UTC]",
+		"weekly/0@2016-01-04T09:00[UTC]",
+		"weekly/x@2016-01-04T09:00[UTC]",
+		"weekly@2016-01-04[UTC]",
+		"weekly@2016-01-04T09:00[Nowhere/Special]",
+		"weekly@2016-01-04T09:00[UTC",
+		"weekly@2016-01-04T09:00[UTC];gap=ignore",
+		"weekly@2016-01-04T09:00[UTC];sometimes",
+	}
+	for _, test := range tests {
+		var s Schedule
+		if err := s.UnmarshalText([]byte(test)); err == nil {
+			t.Errorf("%q: expected an error, got %v", test, s)
+		}
+	}
+}
+
+func TestScheduleSliceText(t *testing.T) {
+	schedules := ScheduleSlice{
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 5, 19, 0, 0, 0, time.UTC)),
+		NewMonthlyScheduleByWeekday(time.Date(2016, time.September, 19, 19, 0, 0, 0, time.UTC)),
+	}
+	expected := "monthly-by-weekday@2016-09-05T19:00[UTC],monthly-by-weekday@2016-09-19T19:00[UTC]"
+
+	// Text encoding allows slices to be used as command line flags
+	var decoded ScheduleSlice
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.TextVar(&decoded, "schedule", ScheduleSlice{}, "meeting schedule")
+	if err := flags.Parse([]string{"-schedule", expected}); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || !decoded[1].First.Equal(schedules[1].First) {
+		t.Errorf("decoded: expected %v got %v", schedules, decoded)
+	}
+	if s := decoded.String(); s != expected {
+		t.Errorf("String: expected %s got %s", expected, s)
+	}
+	if err := decoded.UnmarshalText(nil); err != ErrEmptySchedule {
+		t.Errorf("expected '%v' got '%v'", ErrEmptySchedule, err)
+	}
+}